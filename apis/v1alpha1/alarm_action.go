@@ -0,0 +1,72 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// The types in this file are not part of the CloudWatch API model. They back
+// the MetricAlarm TypedAlarmActions, TypedOKActions and
+// TypedInsufficientDataActions fields, which the controller builds into alarm
+// action ARNs in the Region and account of the controller.
+
+// AlarmAction is an alarm action whose ARN is built by the controller. Exactly
+// one of AutoScaling, EC2, OpsItem or ResponsePlan must be set.
+type AlarmAction struct {
+	AutoScaling  *AutoScalingAlarmAction  `json:"autoScaling,omitempty"`
+	EC2          *EC2AlarmAction          `json:"ec2,omitempty"`
+	OpsItem      *OpsItemAlarmAction      `json:"opsItem,omitempty"`
+	ResponsePlan *ResponsePlanAlarmAction `json:"responsePlan,omitempty"`
+}
+
+// AutoScalingAlarmAction invokes a scaling policy. The policy belongs either
+// to an EC2 Auto Scaling group, set with AutoScalingGroupName, or to an
+// Application Auto Scaling scalable target, set with ServiceNamespace and
+// ResourceID.
+type AutoScalingAlarmAction struct {
+	// The name of the EC2 Auto Scaling group of the policy.
+	AutoScalingGroupName *string `json:"autoScalingGroupName,omitempty"`
+	// The ID of the scaling policy, the UUID that follows scalingPolicy: in the
+	// policy ARN.
+	PolicyID *string `json:"policyID"`
+	// The name of the scaling policy.
+	PolicyName *string `json:"policyName"`
+	// The identifier of the Application Auto Scaling scalable target, such as
+	// service/my-cluster/my-service for an Amazon ECS service.
+	ResourceID *string `json:"resourceID,omitempty"`
+	// The namespace of the Application Auto Scaling scalable target, such as
+	// ecs, dynamodb or lambda.
+	ServiceNamespace *string `json:"serviceNamespace,omitempty"`
+}
+
+// EC2AlarmAction acts on the EC2 instance that the alarm's metric belongs to.
+// The metric must have an InstanceId dimension.
+type EC2AlarmAction struct {
+	// The action taken on the instance. Valid values are stop, terminate, reboot
+	// and recover.
+	Action *string `json:"action"`
+}
+
+// OpsItemAlarmAction creates a Systems Manager OpsCenter OpsItem.
+type OpsItemAlarmAction struct {
+	// The category of the OpsItem. Valid values are Availability, Cost,
+	// Performance, Recovery and Security.
+	Category *string `json:"category,omitempty"`
+	// The severity of the OpsItem, from 1 (critical) to 4 (low).
+	Severity *int64 `json:"severity"`
+}
+
+// ResponsePlanAlarmAction starts an incident with an Incident Manager response
+// plan.
+type ResponsePlanAlarmAction struct {
+	// The name of the response plan.
+	Name *string `json:"name"`
+}
@@ -23,20 +23,50 @@ import (
 // DashboardSpec defines the desired state of Dashboard.
 type DashboardSpec struct {
 
+	// The ARNs of the alarms referenced from the dashboard, resolved from AlarmRefs.
+	AlarmARNs []*string                                  `json:"alarmARNs,omitempty"`
+	AlarmRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"alarmRefs,omitempty"`
 	// The detailed information about the dashboard in JSON format, including the
-	// widgets to include and their location on the dashboard. This parameter is
-	// required.
+	// widgets to include and their location on the dashboard. Exactly one of
+	// DashboardBody, DashboardBodyFrom or Widgets must be set.
 	//
 	// For more information about the syntax, see Dashboard Body Structure and Syntax
 	// (https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch-Dashboard-Body-Structure.html).
-	// +kubebuilder:validation:Required
-	DashboardBody *string `json:"dashboardBody"`
+	//
+	// MetricAlarm resources listed in AlarmRefs can be referenced from the body
+	// or the widgets with a ${name} or ${namespace/name} placeholder, which is
+	// replaced by the ARN of the referenced alarm.
+	DashboardBody *string `json:"dashboardBody,omitempty"`
+	// The ConfigMap key holding the dashboard body, used instead of DashboardBody
+	// to share a dashboard layout between resources. The dashboard is updated
+	// the next time it is reconciled after the ConfigMap changes.
+	DashboardBodyFrom *DashboardBodySource `json:"dashboardBodyFrom,omitempty"`
 	// The name of the dashboard. If a dashboard with this name already exists,
 	// this call modifies that dashboard, replacing its current contents. Otherwise,
 	// a new dashboard is created. The maximum length is 255, and valid characters
 	// are A-Z, a-z, 0-9, "-", and "_". This parameter is required.
 	// +kubebuilder:validation:Required
 	DashboardName *string `json:"dashboardName"`
+	// A list of key-value pairs to associate with the dashboard. You can associate
+	// as many as 50 tags with a dashboard.
+	//
+	// Tags can help you organize and categorize your dashboards. You can also use
+	// them to scope user permissions by granting a user permission to access or
+	// change only dashboards with certain tag values.
+	//
+	// You can use this parameter only when creating a new dashboard. If you specify
+	// Tags when updating an existing dashboard, the tag updates are ignored. To
+	// add or update tags on an existing dashboard, use TagResource (https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_TagResource.html).
+	// To remove tags, use UntagResource (https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_UntagResource.html).
+	Tags []*Tag `json:"tags,omitempty"`
+	// The variables substituted into the dashboard body. Each ${name} placeholder
+	// in DashboardBody, DashboardBodyFrom or Widgets is replaced by the value of
+	// the variable with that name, such as the region or the cluster name.
+	// Variables take precedence over the alarms listed in AlarmRefs.
+	Variables map[string]*string `json:"variables,omitempty"`
+	// The widgets of the dashboard, which are rendered into the dashboard body.
+	// Exactly one of DashboardBody, DashboardBodyFrom or Widgets must be set.
+	Widgets []*DashboardWidget `json:"widgets,omitempty"`
 }
 
 // DashboardStatus defines the observed state of Dashboard
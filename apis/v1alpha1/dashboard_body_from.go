@@ -0,0 +1,31 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// DashboardBodySource is the source of the body of a dashboard when it isn't
+// set inline with DashboardBody.
+type DashboardBodySource struct {
+	// The ConfigMap key holding the dashboard body.
+	ConfigMapKeyRef *ConfigMapKeyReference `json:"configMapKeyRef"`
+}
+
+// ConfigMapKeyReference selects a key of a ConfigMap.
+type ConfigMapKeyReference struct {
+	// The name of the ConfigMap.
+	Name string `json:"name"`
+	// The namespace of the ConfigMap. Defaults to the namespace of the resource.
+	Namespace string `json:"namespace,omitempty"`
+	// The key of the ConfigMap data holding the value.
+	Key string `json:"key"`
+}
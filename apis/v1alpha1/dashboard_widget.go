@@ -0,0 +1,183 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// The types in this file are not part of the CloudWatch API model. They back
+// the Dashboard Widgets field, which the controller renders into the dashboard
+// body described in Dashboard Body Structure and Syntax
+// (https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch-Dashboard-Body-Structure.html).
+
+// DashboardWidget is a widget on a dashboard. Exactly one of Metric, Text,
+// Alarm, Log or Explorer must be set, and determines the type of the widget.
+type DashboardWidget struct {
+	// The horizontal position of the widget on the 24-column grid.
+	X *int64 `json:"x,omitempty"`
+	// The vertical position of the widget on the 24-column grid.
+	Y *int64 `json:"y,omitempty"`
+	// The width of the widget in grid units, from 1 to 24. The default is 6.
+	Width *int64 `json:"width,omitempty"`
+	// The height of the widget in grid units, from 1 to 1000. The default is 6.
+	Height *int64 `json:"height,omitempty"`
+
+	Alarm    *DashboardAlarmWidget    `json:"alarm,omitempty"`
+	Explorer *DashboardExplorerWidget `json:"explorer,omitempty"`
+	Log      *DashboardLogWidget      `json:"log,omitempty"`
+	Metric   *DashboardMetricWidget   `json:"metric,omitempty"`
+	Text     *DashboardTextWidget     `json:"text,omitempty"`
+}
+
+// DashboardMetricWidget is a graph or number of one or more metrics or metric
+// math expressions.
+type DashboardMetricWidget struct {
+	// Whether the graph refreshes with the most recent data points.
+	LiveData *bool `json:"liveData,omitempty"`
+	// The metrics and metric math expressions displayed by the widget.
+	Metrics []*DashboardMetric `json:"metrics"`
+	// The default period of the metrics, in seconds.
+	Period *int64 `json:"period,omitempty"`
+	// The Region of the metrics.
+	Region *string `json:"region,omitempty"`
+	// Whether the period of the metrics follows the time range of the dashboard.
+	SetPeriodToTimeRange *bool `json:"setPeriodToTimeRange,omitempty"`
+	// Whether a sparkline is shown below a singleValue widget.
+	Sparkline *bool `json:"sparkline,omitempty"`
+	// Whether the metrics are stacked in a timeSeries graph.
+	Stacked *bool `json:"stacked,omitempty"`
+	// The default statistic of the metrics, such as Average or p99.
+	Stat *string `json:"stat,omitempty"`
+	// The time zone of the graph, as an offset such as +0130.
+	Timezone *string `json:"timezone,omitempty"`
+	// The title of the widget.
+	Title *string `json:"title,omitempty"`
+	// How the metrics are displayed. Valid values are timeSeries, singleValue,
+	// gauge, bar and pie.
+	View *string `json:"view,omitempty"`
+}
+
+// DashboardMetric is a metric or a metric math expression displayed by a
+// metric widget. Either Namespace and MetricName, or Expression must be set.
+type DashboardMetric struct {
+	// The ID of the account that the metric belongs to.
+	AccountID *string `json:"accountID,omitempty"`
+	// The color of the line, as a six-digit hex code such as #1f77b4.
+	Color      *string      `json:"color,omitempty"`
+	Dimensions []*Dimension `json:"dimensions,omitempty"`
+	// A metric math expression. When set, Namespace, MetricName and Dimensions
+	// must not be set.
+	Expression *string `json:"expression,omitempty"`
+	// The ID of the metric, used to refer to it from expressions.
+	ID *string `json:"id,omitempty"`
+	// The label of the metric in the legend.
+	Label      *string `json:"label,omitempty"`
+	MetricName *string `json:"metricName,omitempty"`
+	Namespace  *string `json:"namespace,omitempty"`
+	// The period of the metric, in seconds.
+	Period *int64 `json:"period,omitempty"`
+	// The Region of the metric.
+	Region *string `json:"region,omitempty"`
+	// The statistic of the metric.
+	Stat *string `json:"stat,omitempty"`
+	// Whether the metric is displayed. Hidden metrics can still be used in
+	// expressions.
+	Visible *bool `json:"visible,omitempty"`
+	// The Y axis of the metric. Valid values are left and right.
+	YAxis *string `json:"yAxis,omitempty"`
+}
+
+// DashboardTextWidget is a block of Markdown text.
+type DashboardTextWidget struct {
+	// The background of the widget. Valid values are solid and transparent.
+	Background *string `json:"background,omitempty"`
+	// The text of the widget, in Markdown.
+	Markdown *string `json:"markdown"`
+}
+
+// DashboardAlarmWidget shows the state of one or more alarms.
+type DashboardAlarmWidget struct {
+	// The ARNs of the alarms.
+	Alarms []*string `json:"alarms"`
+	// How the alarms are sorted. Valid values are default, stateUpdatedTimestamp
+	// and timestamp.
+	SortBy *string `json:"sortBy,omitempty"`
+	// The alarm states to show. Valid values are ALARM, INSUFFICIENT_DATA and OK.
+	States []*string `json:"states,omitempty"`
+	// The title of the widget.
+	Title *string `json:"title,omitempty"`
+}
+
+// DashboardLogWidget shows the results of a CloudWatch Logs Insights query.
+type DashboardLogWidget struct {
+	// The CloudWatch Logs Insights query, starting with the log groups to query,
+	// such as SOURCE 'my-log-group' | fields @timestamp, @message.
+	Query *string `json:"query"`
+	// The Region of the log groups.
+	Region *string `json:"region,omitempty"`
+	// Whether the results are stacked in a timeSeries graph.
+	Stacked *bool `json:"stacked,omitempty"`
+	// The title of the widget.
+	Title *string `json:"title,omitempty"`
+	// How the results are displayed. Valid values are table, timeSeries, bar
+	// and pie.
+	View *string `json:"view,omitempty"`
+}
+
+// DashboardExplorerWidget shows metrics of the resources that match a set of
+// tags.
+type DashboardExplorerWidget struct {
+	AggregateBy *DashboardExplorerAggregateBy `json:"aggregateBy,omitempty"`
+	// The tags that the resources must have.
+	Labels []*DashboardExplorerLabel `json:"labels,omitempty"`
+	// The metrics displayed for each resource.
+	Metrics []*DashboardExplorerMetric `json:"metrics"`
+	// The period of the metrics, in seconds.
+	Period *int64 `json:"period,omitempty"`
+	// The Region of the resources.
+	Region *string `json:"region,omitempty"`
+	// The number of rows of graphs per page.
+	RowsPerPage *int64 `json:"rowsPerPage,omitempty"`
+	// The tag or property that the graphs are split by.
+	SplitBy *string `json:"splitBy,omitempty"`
+	// Whether the metrics are stacked in timeSeries graphs.
+	Stacked *bool `json:"stacked,omitempty"`
+	// The title of the widget.
+	Title *string `json:"title,omitempty"`
+	// How the metrics are displayed. Valid values are timeSeries, bar and pie.
+	View *string `json:"view,omitempty"`
+	// The number of graphs per row.
+	WidgetsPerRow *int64 `json:"widgetsPerRow,omitempty"`
+}
+
+// DashboardExplorerAggregateBy aggregates the metrics of the resources that
+// share the value of a tag.
+type DashboardExplorerAggregateBy struct {
+	// The aggregation function. Valid values are avg, max, min and sum.
+	Func *string `json:"func,omitempty"`
+	// The tag key to aggregate by.
+	Key *string `json:"key,omitempty"`
+}
+
+// DashboardExplorerLabel is a tag that the resources of an explorer widget
+// must have.
+type DashboardExplorerLabel struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// DashboardExplorerMetric is a metric displayed by an explorer widget.
+type DashboardExplorerMetric struct {
+	MetricName *string `json:"metricName,omitempty"`
+	// The CloudFormation resource type, such as AWS::EC2::Instance.
+	ResourceType *string `json:"resourceType,omitempty"`
+	Stat         *string `json:"stat,omitempty"`
+}
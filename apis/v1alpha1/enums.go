@@ -23,12 +23,12 @@ const (
 	ActionsSuppressedBy_WaitPeriod      ActionsSuppressedBy = "WaitPeriod"
 )
 
-type AlarmMuteRuleStatus string
+type AlarmMuteRuleStatus_SDK string
 
 const (
-	AlarmMuteRuleStatus_ACTIVE    AlarmMuteRuleStatus = "ACTIVE"
-	AlarmMuteRuleStatus_EXPIRED   AlarmMuteRuleStatus = "EXPIRED"
-	AlarmMuteRuleStatus_SCHEDULED AlarmMuteRuleStatus = "SCHEDULED"
+	AlarmMuteRuleStatus_SDK_ACTIVE    AlarmMuteRuleStatus_SDK = "ACTIVE"
+	AlarmMuteRuleStatus_SDK_EXPIRED   AlarmMuteRuleStatus_SDK = "EXPIRED"
+	AlarmMuteRuleStatus_SDK_SCHEDULED AlarmMuteRuleStatus_SDK = "SCHEDULED"
 )
 
 type AlarmType string
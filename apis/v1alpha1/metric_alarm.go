@@ -75,7 +75,8 @@ type MetricAlarmSpec struct {
 	// # Start a Amazon Q Developer operational investigation
 	//
 	// arn:aws:aiops:region:account-id:investigation-group:investigation-group-id
-	AlarmActions []*string `json:"alarmActions,omitempty"`
+	AlarmActions    []*string                                  `json:"alarmActions,omitempty"`
+	AlarmActionRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"alarmActionRefs,omitempty"`
 	// The description for the alarm.
 	AlarmDescription *string `json:"alarmDescription,omitempty"`
 	// The arithmetic operation to use when comparing the specified statistic and
@@ -212,7 +213,8 @@ type MetricAlarmSpec struct {
 	//   - arn:aws:ssm:region:account-id:opsitem:severity#CATEGORY=category-name
 	//
 	//   - arn:aws:ssm-incidents::account-id:responseplan/response-plan-name
-	InsufficientDataActions []*string `json:"insufficientDataActions,omitempty"`
+	InsufficientDataActions    []*string                                  `json:"insufficientDataActions,omitempty"`
+	InsufficientDataActionRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"insufficientDataActionRefs,omitempty"`
 	// The name for the metric associated with the alarm. For each PutMetricAlarm
 	// operation, you must specify either MetricName, a Metrics array, or an EvaluationCriteria.
 	//
@@ -290,7 +292,8 @@ type MetricAlarmSpec struct {
 	//   - arn:aws:ssm:region:account-id:opsitem:severity#CATEGORY=category-name
 	//
 	//   - arn:aws:ssm-incidents::account-id:responseplan/response-plan-name
-	OKActions []*string `json:"oKActions,omitempty"`
+	OKActions    []*string                                  `json:"oKActions,omitempty"`
+	OKActionRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"oKActionRefs,omitempty"`
 	// The length, in seconds, used each time the metric specified in MetricName
 	// is evaluated. Valid values are 10, 20, 30, and any multiple of 60.
 	//
@@ -361,6 +364,16 @@ type MetricAlarmSpec struct {
 	//
 	// This parameter is not applicable to PromQL alarms.
 	TreatMissingData *string `json:"treatMissingData,omitempty"`
+	// Alarm actions built by the controller from their parameters, for EC2 instance
+	// actions, Auto Scaling policies, Systems Manager OpsItems and Incident Manager
+	// response plans. Their ARNs are added to AlarmActions when the alarm is put.
+	TypedAlarmActions []*AlarmAction `json:"typedAlarmActions,omitempty"`
+	// INSUFFICIENT_DATA actions built by the controller from their parameters.
+	// Their ARNs are added to InsufficientDataActions when the alarm is put.
+	TypedInsufficientDataActions []*AlarmAction `json:"typedInsufficientDataActions,omitempty"`
+	// OK actions built by the controller from their parameters. Their ARNs are
+	// added to OKActions when the alarm is put.
+	TypedOKActions []*AlarmAction `json:"typedOKActions,omitempty"`
 	// The unit of measure for the statistic. For example, the units for the Amazon
 	// EC2 NetworkIn metric are Bytes because NetworkIn tracks the number of bytes
 	// that an instance receives on all network interfaces. You can also specify
@@ -397,11 +410,31 @@ type MetricAlarmStatus struct {
 	// resource
 	// +kubebuilder:validation:Optional
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// An explanation for the alarm state, in text format.
+	// +kubebuilder:validation:Optional
+	StateReason *string `json:"stateReason,omitempty"`
+	// An explanation for the alarm state, in JSON format.
+	// +kubebuilder:validation:Optional
+	StateReasonData *string `json:"stateReasonData,omitempty"`
+	// The date and time that the alarm's StateValue most recently changed.
+	// +kubebuilder:validation:Optional
+	StateTransitionedTimestamp *metav1.Time `json:"stateTransitionedTimestamp,omitempty"`
+	// The time stamp of the last update to the value of either the StateValue
+	// or EvaluationState parameters.
+	// +kubebuilder:validation:Optional
+	StateUpdatedTimestamp *metav1.Time `json:"stateUpdatedTimestamp,omitempty"`
+	// The state value for the alarm.
+	// +kubebuilder:validation:Optional
+	StateValue *string `json:"stateValue,omitempty"`
 }
 
 // MetricAlarm is the Schema for the MetricAlarms API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATE",type=string,priority=0,JSONPath=`.status.stateValue`
+// +kubebuilder:printcolumn:name="STATE TRANSITIONED",type=date,priority=1,JSONPath=`.status.stateTransitionedTimestamp`
+// +kubebuilder:printcolumn:name="Synced",type="string",priority=0,JSONPath=".status.conditions[?(@.type==\"ACK.ResourceSynced\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
 type MetricAlarm struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
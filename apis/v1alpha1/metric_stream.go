@@ -65,6 +65,11 @@ type MetricStreamSpec struct {
 	//   - firehose:PutRecordBatch
 	RoleARN *string                                  `json:"roleARN,omitempty"`
 	RoleRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"roleRef,omitempty"`
+	// The desired state of the metric stream. Valid values are running and stopped.
+	// The stream is started with StartMetricStreams or stopped with StopMetricStreams
+	// when the observed state differs. When this field is not set, the state of
+	// the stream is left unchanged.
+	State *string `json:"state,omitempty"`
 	// By default, a metric stream always sends the MAX, MIN, SUM, and SAMPLECOUNT
 	// statistics for each metric that is streamed. You can use this parameter to
 	// have the metric stream also send additional statistics in the stream. This
@@ -106,11 +111,23 @@ type MetricStreamStatus struct {
 	// resource
 	// +kubebuilder:validation:Optional
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// The date that the metric stream was created.
+	// +kubebuilder:validation:Optional
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+	// The date of the most recent update to the metric stream's configuration.
+	// +kubebuilder:validation:Optional
+	LastUpdateDate *metav1.Time `json:"lastUpdateDate,omitempty"`
+	// The state of the metric stream. The possible values are running and stopped.
+	// +kubebuilder:validation:Optional
+	StreamState *string `json:"streamState,omitempty"`
 }
 
 // MetricStream is the Schema for the MetricStreams API
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="STATE",type=string,priority=0,JSONPath=`.status.streamState`
+// +kubebuilder:printcolumn:name="Synced",type="string",priority=0,JSONPath=".status.conditions[?(@.type==\"ACK.ResourceSynced\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
 type MetricStream struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
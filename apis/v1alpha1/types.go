@@ -46,8 +46,11 @@ type AlarmHistoryItem struct {
 // Summary information about an alarm mute rule, including its name, status,
 // and configuration details.
 type AlarmMuteRuleSummary struct {
+	AlarmMuteRuleARN     *string      `json:"alarmMuteRuleARN,omitempty"`
 	ExpireDate           *metav1.Time `json:"expireDate,omitempty"`
 	LastUpdatedTimestamp *metav1.Time `json:"lastUpdatedTimestamp,omitempty"`
+	MuteType             *string      `json:"muteType,omitempty"`
+	Status               *string      `json:"status,omitempty"`
 }
 
 // Contains the configuration that determines how a PromQL alarm evaluates its
@@ -66,14 +69,41 @@ type AlarmPromQLCriteria struct {
 // If you have enabled unified cross-account observability, and this account
 // is a monitoring account, the metric can be in the same account or a source
 // account.
-type AnomalyDetector struct {
-	Dimensions []*Dimension `json:"dimensions,omitempty"`
-	MetricName *string      `json:"metricName,omitempty"`
-	Namespace  *string      `json:"namespace,omitempty"`
+type AnomalyDetector_SDK struct {
+	AnomalyDetectorID *string `json:"anomalyDetectorID,omitempty"`
+	// The configuration specifies details about how the anomaly detection model
+	// is to be trained, including time ranges to exclude from use for training
+	// the model and the time zone to use for the metric.
+	Configuration *AnomalyDetectorConfiguration `json:"configuration,omitempty"`
+	Dimensions    []*Dimension                  `json:"dimensions,omitempty"`
+	// This object includes parameters that you can use to provide information
+	// to CloudWatch to help it build more accurate anomaly detection models.
+	MetricCharacteristics *MetricCharacteristics `json:"metricCharacteristics,omitempty"`
+	// Indicates the CloudWatch math expression that provides the time series the
+	// anomaly detector uses as input. The designated math expression must return
+	// a single time series.
+	MetricMathAnomalyDetector *MetricMathAnomalyDetector `json:"metricMathAnomalyDetector,omitempty"`
+	MetricName                *string                    `json:"metricName,omitempty"`
+	Namespace                 *string                    `json:"namespace,omitempty"`
+	// Designates the CloudWatch metric and statistic that provides the time series
+	// the anomaly detector uses as input. If you have enabled unified cross-account
+	// observability, and this account is a monitoring account, the metric can be
+	// in the same account or a source account.
+	SingleMetricAnomalyDetector *SingleMetricAnomalyDetector `json:"singleMetricAnomalyDetector,omitempty"`
+	Stat                        *string                      `json:"stat,omitempty"`
+	StateValue                  *string                      `json:"stateValue,omitempty"`
+}
+
+// The configuration specifies details about how the anomaly detection model
+// is to be trained, including time ranges to exclude from use for training
+// the model and the time zone to use for the metric.
+type AnomalyDetectorConfiguration struct {
+	ExcludedTimeRanges []*Range `json:"excludedTimeRanges,omitempty"`
+	MetricTimezone     *string  `json:"metricTimezone,omitempty"`
 }
 
 // The details about a composite alarm.
-type CompositeAlarm struct {
+type CompositeAlarm_SDK struct {
 	ActionsEnabled                     *bool        `json:"actionsEnabled,omitempty"`
 	ActionsSuppressedBy                *string      `json:"actionsSuppressedBy,omitempty"`
 	ActionsSuppressedReason            *string      `json:"actionsSuppressedReason,omitempty"`
@@ -191,7 +221,7 @@ type InsightRuleMetricDatapoint struct {
 }
 
 // The details about a log alarm.
-type LogAlarm struct {
+type LogAlarm_SDK struct {
 	ActionLogLineCount                 *int64       `json:"actionLogLineCount,omitempty"`
 	ActionLogLineRoleARN               *string      `json:"actionLogLineRoleARN,omitempty"`
 	ActionsEnabled                     *bool        `json:"actionsEnabled,omitempty"`
@@ -221,14 +251,23 @@ type LogAlarm struct {
 // Contains the information that's required to enable a managed Contributor
 // Insights rule for an Amazon Web Services resource.
 type ManagedRule struct {
-	ResourceARN *string `json:"resourceARN,omitempty"`
-	Tags        []*Tag  `json:"tags,omitempty"`
+	ResourceARN  *string `json:"resourceARN,omitempty"`
+	Tags         []*Tag  `json:"tags,omitempty"`
+	TemplateName *string `json:"templateName,omitempty"`
 }
 
 // Contains information about managed Contributor Insights rules, as returned
 // by ListManagedInsightRules.
 type ManagedRuleDescription struct {
-	ResourceARN *string `json:"resourceARN,omitempty"`
+	ResourceARN  *string           `json:"resourceARN,omitempty"`
+	RuleState    *ManagedRuleState `json:"ruleState,omitempty"`
+	TemplateName *string           `json:"templateName,omitempty"`
+}
+
+// The status of a managed Contributor Insights rule.
+type ManagedRuleState struct {
+	RuleName *string `json:"ruleName,omitempty"`
+	State    *string `json:"state,omitempty"`
 }
 
 // Represents a specific metric.
@@ -283,6 +322,12 @@ type MetricAlarm_SDK struct {
 	Unit                       *string            `json:"unit,omitempty"`
 }
 
+// This object includes parameters that you can use to provide information
+// to CloudWatch to help it build more accurate anomaly detection models.
+type MetricCharacteristics struct {
+	PeriodicSpikes *bool `json:"periodicSpikes,omitempty"`
+}
+
 // This structure is used in both GetMetricData and PutMetricAlarm. The supported
 // use of this structure is different for those two operations.
 //
@@ -394,6 +439,12 @@ type MetricStreamStatisticsMetric struct {
 	Namespace  *string `json:"namespace,omitempty"`
 }
 
+// Specifies which alarms an alarm mute rule applies to.
+type MuteTargets struct {
+	AlarmNames []*string                                  `json:"alarmNames,omitempty"`
+	AlarmRefs  []*ackv1alpha1.AWSResourceReferenceWrapper `json:"alarmRefs,omitempty"`
+}
+
 // Specifies one range of days or times to exclude from use for training an
 // anomaly detection model.
 type Range struct {
@@ -401,13 +452,24 @@ type Range struct {
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 }
 
+// Defines the schedule configuration for an alarm mute rule.
+//
+// The rule contains a schedule that specifies when and how long alarms should
+// be muted. The schedule can be a recurring pattern using cron expressions
+// or a one-time mute window using at expressions.
+type Rule struct {
+	Schedule *Schedule `json:"schedule,omitempty"`
+}
+
 // Specifies when and how long an alarm mute rule is active.
 //
 // The schedule uses either a cron expression for recurring mute windows or
 // an at expression for one-time mute windows. When the schedule activates,
 // the mute rule mutes alarm actions for the specified duration.
 type Schedule struct {
-	Timezone *string `json:"timezone,omitempty"`
+	Duration   *string `json:"duration,omitempty"`
+	Expression *string `json:"expression,omitempty"`
+	Timezone   *string `json:"timezone,omitempty"`
 }
 
 // Contains the schedule expression and time-range offsets that define when
@@ -421,15 +483,17 @@ type ScheduleConfiguration struct {
 // The configuration of the CloudWatch Logs scheduled query that backs a log
 // alarm.
 type ScheduledQueryConfiguration struct {
-	AggregationExpression *string   `json:"aggregationExpression,omitempty"`
-	LogGroupIdentifiers   []*string `json:"logGroupIdentifiers,omitempty"`
-	QueryARN              *string   `json:"queryARN,omitempty"`
-	QueryString           *string   `json:"queryString,omitempty"`
+	AggregationExpression *string                                    `json:"aggregationExpression,omitempty"`
+	LogGroupIdentifiers   []*string                                  `json:"logGroupIdentifiers,omitempty"`
+	LogGroupRefs          []*ackv1alpha1.AWSResourceReferenceWrapper `json:"logGroupRefs,omitempty"`
+	QueryARN              *string                                    `json:"queryARN,omitempty"`
+	QueryString           *string                                    `json:"queryString,omitempty"`
 	// Contains the schedule expression and time-range offsets that define when
 	// a scheduled query runs and what time range each execution covers.
-	ScheduleConfiguration *ScheduleConfiguration `json:"scheduleConfiguration,omitempty"`
-	ScheduledQueryRoleARN *string                `json:"scheduledQueryRoleARN,omitempty"`
-	Tags                  []*Tag                 `json:"tags,omitempty"`
+	ScheduleConfiguration *ScheduleConfiguration                   `json:"scheduleConfiguration,omitempty"`
+	ScheduledQueryRoleARN *string                                  `json:"scheduledQueryRoleARN,omitempty"`
+	ScheduledQueryRoleRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"scheduledQueryRoleRef,omitempty"`
+	Tags                  []*Tag                                   `json:"tags,omitempty"`
 }
 
 // Designates the CloudWatch metric and statistic that provides the time series
@@ -441,6 +505,7 @@ type SingleMetricAnomalyDetector struct {
 	Dimensions []*Dimension `json:"dimensions,omitempty"`
 	MetricName *string      `json:"metricName,omitempty"`
 	Namespace  *string      `json:"namespace,omitempty"`
+	Stat       *string      `json:"stat,omitempty"`
 }
 
 // A key-value pair associated with a CloudWatch resource.
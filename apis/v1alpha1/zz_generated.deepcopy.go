@@ -24,6 +24,41 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmAction) DeepCopyInto(out *AlarmAction) {
+	*out = *in
+	if in.AutoScaling != nil {
+		in, out := &in.AutoScaling, &out.AutoScaling
+		*out = new(AutoScalingAlarmAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EC2 != nil {
+		in, out := &in.EC2, &out.EC2
+		*out = new(EC2AlarmAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpsItem != nil {
+		in, out := &in.OpsItem, &out.OpsItem
+		*out = new(OpsItemAlarmAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponsePlan != nil {
+		in, out := &in.ResponsePlan, &out.ResponsePlan
+		*out = new(ResponsePlanAlarmAction)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmAction.
+func (in *AlarmAction) DeepCopy() *AlarmAction {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlarmContributor) DeepCopyInto(out *AlarmContributor) {
 	*out = *in
@@ -77,9 +112,172 @@ func (in *AlarmHistoryItem) DeepCopy() *AlarmHistoryItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmMuteRule) DeepCopyInto(out *AlarmMuteRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmMuteRule.
+func (in *AlarmMuteRule) DeepCopy() *AlarmMuteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmMuteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlarmMuteRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmMuteRuleList) DeepCopyInto(out *AlarmMuteRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlarmMuteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmMuteRuleList.
+func (in *AlarmMuteRuleList) DeepCopy() *AlarmMuteRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmMuteRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlarmMuteRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmMuteRuleSpec) DeepCopyInto(out *AlarmMuteRuleSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpireDate != nil {
+		in, out := &in.ExpireDate, &out.ExpireDate
+		*out = (*in).DeepCopy()
+	}
+	if in.MuteTargets != nil {
+		in, out := &in.MuteTargets, &out.MuteTargets
+		*out = new(MuteTargets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = new(Rule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartDate != nil {
+		in, out := &in.StartDate, &out.StartDate
+		*out = (*in).DeepCopy()
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmMuteRuleSpec.
+func (in *AlarmMuteRuleSpec) DeepCopy() *AlarmMuteRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmMuteRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmMuteRuleStatus) DeepCopyInto(out *AlarmMuteRuleStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.LastUpdatedTimestamp != nil {
+		in, out := &in.LastUpdatedTimestamp, &out.LastUpdatedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.MuteType != nil {
+		in, out := &in.MuteType, &out.MuteType
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmMuteRuleStatus.
+func (in *AlarmMuteRuleStatus) DeepCopy() *AlarmMuteRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmMuteRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlarmMuteRuleSummary) DeepCopyInto(out *AlarmMuteRuleSummary) {
 	*out = *in
+	if in.AlarmMuteRuleARN != nil {
+		in, out := &in.AlarmMuteRuleARN, &out.AlarmMuteRuleARN
+		*out = new(string)
+		**out = **in
+	}
 	if in.ExpireDate != nil {
 		in, out := &in.ExpireDate, &out.ExpireDate
 		*out = (*in).DeepCopy()
@@ -88,6 +286,16 @@ func (in *AlarmMuteRuleSummary) DeepCopyInto(out *AlarmMuteRuleSummary) {
 		in, out := &in.LastUpdatedTimestamp, &out.LastUpdatedTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.MuteType != nil {
+		in, out := &in.MuteType, &out.MuteType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmMuteRuleSummary.
@@ -133,102 +341,400 @@ func (in *AlarmPromQLCriteria) DeepCopy() *AlarmPromQLCriteria {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnomalyDetector) DeepCopyInto(out *AnomalyDetector) {
 	*out = *in
-	if in.Dimensions != nil {
-		in, out := &in.Dimensions, &out.Dimensions
-		*out = make([]*Dimension, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetector.
+func (in *AnomalyDetector) DeepCopy() *AnomalyDetector {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalyDetector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AnomalyDetector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyDetectorConfiguration) DeepCopyInto(out *AnomalyDetectorConfiguration) {
+	*out = *in
+	if in.ExcludedTimeRanges != nil {
+		in, out := &in.ExcludedTimeRanges, &out.ExcludedTimeRanges
+		*out = make([]*Range, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Dimension)
+				*out = new(Range)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.MetricName != nil {
-		in, out := &in.MetricName, &out.MetricName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
+	if in.MetricTimezone != nil {
+		in, out := &in.MetricTimezone, &out.MetricTimezone
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetector.
-func (in *AnomalyDetector) DeepCopy() *AnomalyDetector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorConfiguration.
+func (in *AnomalyDetectorConfiguration) DeepCopy() *AnomalyDetectorConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(AnomalyDetector)
+	out := new(AnomalyDetectorConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CompositeAlarm) DeepCopyInto(out *CompositeAlarm) {
+func (in *AnomalyDetectorList) DeepCopyInto(out *AnomalyDetectorList) {
 	*out = *in
-	if in.ActionsEnabled != nil {
-		in, out := &in.ActionsEnabled, &out.ActionsEnabled
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AnomalyDetector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ActionsSuppressedBy != nil {
-		in, out := &in.ActionsSuppressedBy, &out.ActionsSuppressedBy
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorList.
+func (in *AnomalyDetectorList) DeepCopy() *AnomalyDetectorList {
+	if in == nil {
+		return nil
 	}
-	if in.ActionsSuppressedReason != nil {
-		in, out := &in.ActionsSuppressedReason, &out.ActionsSuppressedReason
-		*out = new(string)
-		**out = **in
+	out := new(AnomalyDetectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AnomalyDetectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ActionsSuppressor != nil {
-		in, out := &in.ActionsSuppressor, &out.ActionsSuppressor
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyDetectorSpec) DeepCopyInto(out *AnomalyDetectorSpec) {
+	*out = *in
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(AnomalyDetectorConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ActionsSuppressorExtensionPeriod != nil {
-		in, out := &in.ActionsSuppressorExtensionPeriod, &out.ActionsSuppressorExtensionPeriod
-		*out = new(int64)
-		**out = **in
+	if in.MetricCharacteristics != nil {
+		in, out := &in.MetricCharacteristics, &out.MetricCharacteristics
+		*out = new(MetricCharacteristics)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ActionsSuppressorWaitPeriod != nil {
-		in, out := &in.ActionsSuppressorWaitPeriod, &out.ActionsSuppressorWaitPeriod
-		*out = new(int64)
-		**out = **in
+	if in.MetricMathAnomalyDetector != nil {
+		in, out := &in.MetricMathAnomalyDetector, &out.MetricMathAnomalyDetector
+		*out = new(MetricMathAnomalyDetector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AlarmActions != nil {
-		in, out := &in.AlarmActions, &out.AlarmActions
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
+	if in.SingleMetricAnomalyDetector != nil {
+		in, out := &in.SingleMetricAnomalyDetector, &out.SingleMetricAnomalyDetector
+		*out = new(SingleMetricAnomalyDetector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AlarmARN != nil {
-		in, out := &in.AlarmARN, &out.AlarmARN
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorSpec.
+func (in *AnomalyDetectorSpec) DeepCopy() *AnomalyDetectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalyDetectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyDetectorStatus) DeepCopyInto(out *AnomalyDetectorStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AnomalyDetectorID != nil {
+		in, out := &in.AnomalyDetectorID, &out.AnomalyDetectorID
 		*out = new(string)
 		**out = **in
 	}
-	if in.AlarmConfigurationUpdatedTimestamp != nil {
-		in, out := &in.AlarmConfigurationUpdatedTimestamp, &out.AlarmConfigurationUpdatedTimestamp
-		*out = (*in).DeepCopy()
+	if in.StateValue != nil {
+		in, out := &in.StateValue, &out.StateValue
+		*out = new(string)
+		**out = **in
 	}
-	if in.AlarmDescription != nil {
-		in, out := &in.AlarmDescription, &out.AlarmDescription
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetectorStatus.
+func (in *AnomalyDetectorStatus) DeepCopy() *AnomalyDetectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalyDetectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyDetector_SDK) DeepCopyInto(out *AnomalyDetector_SDK) {
+	*out = *in
+	if in.AnomalyDetectorID != nil {
+		in, out := &in.AnomalyDetectorID, &out.AnomalyDetectorID
 		*out = new(string)
 		**out = **in
 	}
-	if in.AlarmName != nil {
-		in, out := &in.AlarmName, &out.AlarmName
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(AnomalyDetectorConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]*Dimension, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Dimension)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.MetricCharacteristics != nil {
+		in, out := &in.MetricCharacteristics, &out.MetricCharacteristics
+		*out = new(MetricCharacteristics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricMathAnomalyDetector != nil {
+		in, out := &in.MetricMathAnomalyDetector, &out.MetricMathAnomalyDetector
+		*out = new(MetricMathAnomalyDetector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricName != nil {
+		in, out := &in.MetricName, &out.MetricName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.SingleMetricAnomalyDetector != nil {
+		in, out := &in.SingleMetricAnomalyDetector, &out.SingleMetricAnomalyDetector
+		*out = new(SingleMetricAnomalyDetector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stat != nil {
+		in, out := &in.Stat, &out.Stat
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateValue != nil {
+		in, out := &in.StateValue, &out.StateValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyDetector_SDK.
+func (in *AnomalyDetector_SDK) DeepCopy() *AnomalyDetector_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalyDetector_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingAlarmAction) DeepCopyInto(out *AutoScalingAlarmAction) {
+	*out = *in
+	if in.AutoScalingGroupName != nil {
+		in, out := &in.AutoScalingGroupName, &out.AutoScalingGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PolicyID != nil {
+		in, out := &in.PolicyID, &out.PolicyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PolicyName != nil {
+		in, out := &in.PolicyName, &out.PolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceNamespace != nil {
+		in, out := &in.ServiceNamespace, &out.ServiceNamespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingAlarmAction.
+func (in *AutoScalingAlarmAction) DeepCopy() *AutoScalingAlarmAction {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingAlarmAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeAlarm) DeepCopyInto(out *CompositeAlarm) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeAlarm.
+func (in *CompositeAlarm) DeepCopy() *CompositeAlarm {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeAlarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CompositeAlarm) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeAlarmList) DeepCopyInto(out *CompositeAlarmList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CompositeAlarm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeAlarmList.
+func (in *CompositeAlarmList) DeepCopy() *CompositeAlarmList {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeAlarmList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CompositeAlarmList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeAlarmSpec) DeepCopyInto(out *CompositeAlarmSpec) {
+	*out = *in
+	if in.ActionsEnabled != nil {
+		in, out := &in.ActionsEnabled, &out.ActionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ActionsSuppressor != nil {
+		in, out := &in.ActionsSuppressor, &out.ActionsSuppressor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionsSuppressorExtensionPeriod != nil {
+		in, out := &in.ActionsSuppressorExtensionPeriod, &out.ActionsSuppressorExtensionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ActionsSuppressorWaitPeriod != nil {
+		in, out := &in.ActionsSuppressorWaitPeriod, &out.ActionsSuppressorWaitPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AlarmActions != nil {
+		in, out := &in.AlarmActions, &out.AlarmActions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AlarmDescription != nil {
+		in, out := &in.AlarmDescription, &out.AlarmDescription
 		*out = new(string)
 		**out = **in
 	}
+	if in.AlarmNames != nil {
+		in, out := &in.AlarmNames, &out.AlarmNames
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AlarmRefs != nil {
+		in, out := &in.AlarmRefs, &out.AlarmRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.AlarmRule != nil {
 		in, out := &in.AlarmRule, &out.AlarmRule
 		*out = new(string)
@@ -245,6 +751,11 @@ func (in *CompositeAlarm) DeepCopyInto(out *CompositeAlarm) {
 			}
 		}
 	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
 	if in.OKActions != nil {
 		in, out := &in.OKActions, &out.OKActions
 		*out = make([]*string, len(*in))
@@ -256,43 +767,1118 @@ func (in *CompositeAlarm) DeepCopyInto(out *CompositeAlarm) {
 			}
 		}
 	}
-	if in.StateReason != nil {
-		in, out := &in.StateReason, &out.StateReason
-		*out = new(string)
-		**out = **in
-	}
-	if in.StateReasonData != nil {
-		in, out := &in.StateReasonData, &out.StateReasonData
-		*out = new(string)
-		**out = **in
-	}
-	if in.StateTransitionedTimestamp != nil {
-		in, out := &in.StateTransitionedTimestamp, &out.StateTransitionedTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.StateUpdatedTimestamp != nil {
-		in, out := &in.StateUpdatedTimestamp, &out.StateUpdatedTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.StateValue != nil {
-		in, out := &in.StateValue, &out.StateValue
-		*out = new(string)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeAlarmSpec.
+func (in *CompositeAlarmSpec) DeepCopy() *CompositeAlarmSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeAlarmSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeAlarmStatus) DeepCopyInto(out *CompositeAlarmStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeAlarmStatus.
+func (in *CompositeAlarmStatus) DeepCopy() *CompositeAlarmStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeAlarmStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeAlarm_SDK) DeepCopyInto(out *CompositeAlarm_SDK) {
+	*out = *in
+	if in.ActionsEnabled != nil {
+		in, out := &in.ActionsEnabled, &out.ActionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ActionsSuppressedBy != nil {
+		in, out := &in.ActionsSuppressedBy, &out.ActionsSuppressedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionsSuppressedReason != nil {
+		in, out := &in.ActionsSuppressedReason, &out.ActionsSuppressedReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionsSuppressor != nil {
+		in, out := &in.ActionsSuppressor, &out.ActionsSuppressor
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionsSuppressorExtensionPeriod != nil {
+		in, out := &in.ActionsSuppressorExtensionPeriod, &out.ActionsSuppressorExtensionPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ActionsSuppressorWaitPeriod != nil {
+		in, out := &in.ActionsSuppressorWaitPeriod, &out.ActionsSuppressorWaitPeriod
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AlarmActions != nil {
+		in, out := &in.AlarmActions, &out.AlarmActions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AlarmARN != nil {
+		in, out := &in.AlarmARN, &out.AlarmARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AlarmConfigurationUpdatedTimestamp != nil {
+		in, out := &in.AlarmConfigurationUpdatedTimestamp, &out.AlarmConfigurationUpdatedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.AlarmDescription != nil {
+		in, out := &in.AlarmDescription, &out.AlarmDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.AlarmName != nil {
+		in, out := &in.AlarmName, &out.AlarmName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AlarmRule != nil {
+		in, out := &in.AlarmRule, &out.AlarmRule
+		*out = new(string)
+		**out = **in
+	}
+	if in.InsufficientDataActions != nil {
+		in, out := &in.InsufficientDataActions, &out.InsufficientDataActions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.OKActions != nil {
+		in, out := &in.OKActions, &out.OKActions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.StateReason != nil {
+		in, out := &in.StateReason, &out.StateReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateReasonData != nil {
+		in, out := &in.StateReasonData, &out.StateReasonData
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateTransitionedTimestamp != nil {
+		in, out := &in.StateTransitionedTimestamp, &out.StateTransitionedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StateUpdatedTimestamp != nil {
+		in, out := &in.StateUpdatedTimestamp, &out.StateUpdatedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StateValue != nil {
+		in, out := &in.StateValue, &out.StateValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeAlarm_SDK.
+func (in *CompositeAlarm_SDK) DeepCopy() *CompositeAlarm_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeAlarm_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dashboard) DeepCopyInto(out *Dashboard) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dashboard.
+func (in *Dashboard) DeepCopy() *Dashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(Dashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Dashboard) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardAlarmWidget) DeepCopyInto(out *DashboardAlarmWidget) {
+	*out = *in
+	if in.Alarms != nil {
+		in, out := &in.Alarms, &out.Alarms
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SortBy != nil {
+		in, out := &in.SortBy, &out.SortBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardAlarmWidget.
+func (in *DashboardAlarmWidget) DeepCopy() *DashboardAlarmWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardAlarmWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardBodySource) DeepCopyInto(out *DashboardBodySource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardBodySource.
+func (in *DashboardBodySource) DeepCopy() *DashboardBodySource {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardBodySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardEntry) DeepCopyInto(out *DashboardEntry) {
+	*out = *in
+	if in.DashboardARN != nil {
+		in, out := &in.DashboardARN, &out.DashboardARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DashboardName != nil {
+		in, out := &in.DashboardName, &out.DashboardName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardEntry.
+func (in *DashboardEntry) DeepCopy() *DashboardEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardExplorerAggregateBy) DeepCopyInto(out *DashboardExplorerAggregateBy) {
+	*out = *in
+	if in.Func != nil {
+		in, out := &in.Func, &out.Func
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardExplorerAggregateBy.
+func (in *DashboardExplorerAggregateBy) DeepCopy() *DashboardExplorerAggregateBy {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardExplorerAggregateBy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardExplorerLabel) DeepCopyInto(out *DashboardExplorerLabel) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardExplorerLabel.
+func (in *DashboardExplorerLabel) DeepCopy() *DashboardExplorerLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardExplorerLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardExplorerMetric) DeepCopyInto(out *DashboardExplorerMetric) {
+	*out = *in
+	if in.MetricName != nil {
+		in, out := &in.MetricName, &out.MetricName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceType != nil {
+		in, out := &in.ResourceType, &out.ResourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Stat != nil {
+		in, out := &in.Stat, &out.Stat
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardExplorerMetric.
+func (in *DashboardExplorerMetric) DeepCopy() *DashboardExplorerMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardExplorerMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardExplorerWidget) DeepCopyInto(out *DashboardExplorerWidget) {
+	*out = *in
+	if in.AggregateBy != nil {
+		in, out := &in.AggregateBy, &out.AggregateBy
+		*out = new(DashboardExplorerAggregateBy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]*DashboardExplorerLabel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DashboardExplorerLabel)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]*DashboardExplorerMetric, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DashboardExplorerMetric)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.RowsPerPage != nil {
+		in, out := &in.RowsPerPage, &out.RowsPerPage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SplitBy != nil {
+		in, out := &in.SplitBy, &out.SplitBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Stacked != nil {
+		in, out := &in.Stacked, &out.Stacked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.View != nil {
+		in, out := &in.View, &out.View
+		*out = new(string)
+		**out = **in
+	}
+	if in.WidgetsPerRow != nil {
+		in, out := &in.WidgetsPerRow, &out.WidgetsPerRow
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardExplorerWidget.
+func (in *DashboardExplorerWidget) DeepCopy() *DashboardExplorerWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardExplorerWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardList) DeepCopyInto(out *DashboardList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Dashboard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardList.
+func (in *DashboardList) DeepCopy() *DashboardList {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DashboardList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardLogWidget) DeepCopyInto(out *DashboardLogWidget) {
+	*out = *in
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Stacked != nil {
+		in, out := &in.Stacked, &out.Stacked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.View != nil {
+		in, out := &in.View, &out.View
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardLogWidget.
+func (in *DashboardLogWidget) DeepCopy() *DashboardLogWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardLogWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardMetric) DeepCopyInto(out *DashboardMetric) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Color != nil {
+		in, out := &in.Color, &out.Color
+		*out = new(string)
+		**out = **in
+	}
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]*Dimension, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Dimension)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Label != nil {
+		in, out := &in.Label, &out.Label
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricName != nil {
+		in, out := &in.MetricName, &out.MetricName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Stat != nil {
+		in, out := &in.Stat, &out.Stat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Visible != nil {
+		in, out := &in.Visible, &out.Visible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.YAxis != nil {
+		in, out := &in.YAxis, &out.YAxis
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardMetric.
+func (in *DashboardMetric) DeepCopy() *DashboardMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardMetricWidget) DeepCopyInto(out *DashboardMetricWidget) {
+	*out = *in
+	if in.LiveData != nil {
+		in, out := &in.LiveData, &out.LiveData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]*DashboardMetric, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DashboardMetric)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SetPeriodToTimeRange != nil {
+		in, out := &in.SetPeriodToTimeRange, &out.SetPeriodToTimeRange
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sparkline != nil {
+		in, out := &in.Sparkline, &out.Sparkline
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Stacked != nil {
+		in, out := &in.Stacked, &out.Stacked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Stat != nil {
+		in, out := &in.Stat, &out.Stat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.View != nil {
+		in, out := &in.View, &out.View
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardMetricWidget.
+func (in *DashboardMetricWidget) DeepCopy() *DashboardMetricWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardMetricWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardSpec) DeepCopyInto(out *DashboardSpec) {
+	*out = *in
+	if in.AlarmARNs != nil {
+		in, out := &in.AlarmARNs, &out.AlarmARNs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AlarmRefs != nil {
+		in, out := &in.AlarmRefs, &out.AlarmRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DashboardBody != nil {
+		in, out := &in.DashboardBody, &out.DashboardBody
+		*out = new(string)
+		**out = **in
+	}
+	if in.DashboardBodyFrom != nil {
+		in, out := &in.DashboardBodyFrom, &out.DashboardBodyFrom
+		*out = new(DashboardBodySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DashboardName != nil {
+		in, out := &in.DashboardName, &out.DashboardName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Widgets != nil {
+		in, out := &in.Widgets, &out.Widgets
+		*out = make([]*DashboardWidget, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DashboardWidget)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardSpec.
+func (in *DashboardSpec) DeepCopy() *DashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DashboardValidationMessages != nil {
+		in, out := &in.DashboardValidationMessages, &out.DashboardValidationMessages
+		*out = make([]*DashboardValidationMessage, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DashboardValidationMessage)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
+func (in *DashboardStatus) DeepCopy() *DashboardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTextWidget) DeepCopyInto(out *DashboardTextWidget) {
+	*out = *in
+	if in.Background != nil {
+		in, out := &in.Background, &out.Background
+		*out = new(string)
+		**out = **in
+	}
+	if in.Markdown != nil {
+		in, out := &in.Markdown, &out.Markdown
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTextWidget.
+func (in *DashboardTextWidget) DeepCopy() *DashboardTextWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTextWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardValidationMessage) DeepCopyInto(out *DashboardValidationMessage) {
+	*out = *in
+	if in.DataPath != nil {
+		in, out := &in.DataPath, &out.DataPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardValidationMessage.
+func (in *DashboardValidationMessage) DeepCopy() *DashboardValidationMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardValidationMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidget) DeepCopyInto(out *DashboardWidget) {
+	*out = *in
+	if in.X != nil {
+		in, out := &in.X, &out.X
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Y != nil {
+		in, out := &in.Y, &out.Y
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Width != nil {
+		in, out := &in.Width, &out.Width
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Height != nil {
+		in, out := &in.Height, &out.Height
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Alarm != nil {
+		in, out := &in.Alarm, &out.Alarm
+		*out = new(DashboardAlarmWidget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Explorer != nil {
+		in, out := &in.Explorer, &out.Explorer
+		*out = new(DashboardExplorerWidget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(DashboardLogWidget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(DashboardMetricWidget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(DashboardTextWidget)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidget.
+func (in *DashboardWidget) DeepCopy() *DashboardWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Datapoint) DeepCopyInto(out *Datapoint) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Datapoint.
+func (in *Datapoint) DeepCopy() *Datapoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Datapoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dimension) DeepCopyInto(out *Dimension) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dimension.
+func (in *Dimension) DeepCopy() *Dimension {
+	if in == nil {
+		return nil
+	}
+	out := new(Dimension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DimensionFilter) DeepCopyInto(out *DimensionFilter) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DimensionFilter.
+func (in *DimensionFilter) DeepCopy() *DimensionFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(DimensionFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2AlarmAction) DeepCopyInto(out *EC2AlarmAction) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2AlarmAction.
+func (in *EC2AlarmAction) DeepCopy() *EC2AlarmAction {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2AlarmAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationCriteria) DeepCopyInto(out *EvaluationCriteria) {
+	*out = *in
+	if in.PromQLCriteria != nil {
+		in, out := &in.PromQLCriteria, &out.PromQLCriteria
+		*out = new(AlarmPromQLCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationCriteria.
+func (in *EvaluationCriteria) DeepCopy() *EvaluationCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationWindow) DeepCopyInto(out *EvaluationWindow) {
+	*out = *in
+	if in.SlidingWindow != nil {
+		in, out := &in.SlidingWindow, &out.SlidingWindow
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.WallClockWindow != nil {
+		in, out := &in.WallClockWindow, &out.WallClockWindow
+		*out = new(WallClockWindow)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeAlarm.
-func (in *CompositeAlarm) DeepCopy() *CompositeAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationWindow.
+func (in *EvaluationWindow) DeepCopy() *EvaluationWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(CompositeAlarm)
+	out := new(EvaluationWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Dashboard) DeepCopyInto(out *Dashboard) {
+func (in *InsightRule) DeepCopyInto(out *InsightRule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -300,18 +1886,18 @@ func (in *Dashboard) DeepCopyInto(out *Dashboard) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dashboard.
-func (in *Dashboard) DeepCopy() *Dashboard {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRule.
+func (in *InsightRule) DeepCopy() *InsightRule {
 	if in == nil {
 		return nil
 	}
-	out := new(Dashboard)
+	out := new(InsightRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Dashboard) DeepCopyObject() runtime.Object {
+func (in *InsightRule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -319,65 +1905,50 @@ func (in *Dashboard) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DashboardEntry) DeepCopyInto(out *DashboardEntry) {
+func (in *InsightRuleContributorDatapoint) DeepCopyInto(out *InsightRuleContributorDatapoint) {
 	*out = *in
-	if in.DashboardARN != nil {
-		in, out := &in.DashboardARN, &out.DashboardARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.DashboardName != nil {
-		in, out := &in.DashboardName, &out.DashboardName
-		*out = new(string)
-		**out = **in
-	}
-	if in.LastModified != nil {
-		in, out := &in.LastModified, &out.LastModified
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
 		*out = (*in).DeepCopy()
 	}
-	if in.Size != nil {
-		in, out := &in.Size, &out.Size
-		*out = new(int64)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardEntry.
-func (in *DashboardEntry) DeepCopy() *DashboardEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRuleContributorDatapoint.
+func (in *InsightRuleContributorDatapoint) DeepCopy() *InsightRuleContributorDatapoint {
 	if in == nil {
 		return nil
 	}
-	out := new(DashboardEntry)
+	out := new(InsightRuleContributorDatapoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DashboardList) DeepCopyInto(out *DashboardList) {
+func (in *InsightRuleList) DeepCopyInto(out *InsightRuleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Dashboard, len(*in))
+		*out = make([]InsightRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardList.
-func (in *DashboardList) DeepCopy() *DashboardList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRuleList.
+func (in *InsightRuleList) DeepCopy() *InsightRuleList {
 	if in == nil {
 		return nil
 	}
-	out := new(DashboardList)
+	out := new(InsightRuleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DashboardList) DeepCopyObject() runtime.Object {
+func (in *InsightRuleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -385,32 +1956,72 @@ func (in *DashboardList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DashboardSpec) DeepCopyInto(out *DashboardSpec) {
+func (in *InsightRuleMetricDatapoint) DeepCopyInto(out *InsightRuleMetricDatapoint) {
 	*out = *in
-	if in.DashboardBody != nil {
-		in, out := &in.DashboardBody, &out.DashboardBody
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRuleMetricDatapoint.
+func (in *InsightRuleMetricDatapoint) DeepCopy() *InsightRuleMetricDatapoint {
+	if in == nil {
+		return nil
+	}
+	out := new(InsightRuleMetricDatapoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsightRuleSpec) DeepCopyInto(out *InsightRuleSpec) {
+	*out = *in
+	if in.ApplyOnTransformedLogs != nil {
+		in, out := &in.ApplyOnTransformedLogs, &out.ApplyOnTransformedLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.DashboardName != nil {
-		in, out := &in.DashboardName, &out.DashboardName
+	if in.RuleDefinition != nil {
+		in, out := &in.RuleDefinition, &out.RuleDefinition
 		*out = new(string)
 		**out = **in
 	}
+	if in.RuleState != nil {
+		in, out := &in.RuleState, &out.RuleState
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardSpec.
-func (in *DashboardSpec) DeepCopy() *DashboardSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRuleSpec.
+func (in *InsightRuleSpec) DeepCopy() *InsightRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DashboardSpec)
+	out := new(InsightRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
+func (in *InsightRuleStatus) DeepCopyInto(out *InsightRuleStatus) {
 	*out = *in
 	if in.ACKResourceMetadata != nil {
 		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
@@ -428,224 +2039,266 @@ func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
 			}
 		}
 	}
-	if in.DashboardValidationMessages != nil {
-		in, out := &in.DashboardValidationMessages, &out.DashboardValidationMessages
-		*out = make([]*DashboardValidationMessage, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(DashboardValidationMessage)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
-func (in *DashboardStatus) DeepCopy() *DashboardStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRuleStatus.
+func (in *InsightRuleStatus) DeepCopy() *InsightRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DashboardStatus)
+	out := new(InsightRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DashboardValidationMessage) DeepCopyInto(out *DashboardValidationMessage) {
+func (in *LogAlarm) DeepCopyInto(out *LogAlarm) {
 	*out = *in
-	if in.DataPath != nil {
-		in, out := &in.DataPath, &out.DataPath
-		*out = new(string)
-		**out = **in
-	}
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardValidationMessage.
-func (in *DashboardValidationMessage) DeepCopy() *DashboardValidationMessage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAlarm.
+func (in *LogAlarm) DeepCopy() *LogAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(DashboardValidationMessage)
+	out := new(LogAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogAlarm) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Datapoint) DeepCopyInto(out *Datapoint) {
+func (in *LogAlarmList) DeepCopyInto(out *LogAlarmList) {
 	*out = *in
-	if in.Timestamp != nil {
-		in, out := &in.Timestamp, &out.Timestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.Unit != nil {
-		in, out := &in.Unit, &out.Unit
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogAlarm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Datapoint.
-func (in *Datapoint) DeepCopy() *Datapoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAlarmList.
+func (in *LogAlarmList) DeepCopy() *LogAlarmList {
 	if in == nil {
 		return nil
 	}
-	out := new(Datapoint)
+	out := new(LogAlarmList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogAlarmList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Dimension) DeepCopyInto(out *Dimension) {
+func (in *LogAlarmSpec) DeepCopyInto(out *LogAlarmSpec) {
 	*out = *in
+	if in.ActionLogLineCount != nil {
+		in, out := &in.ActionLogLineCount, &out.ActionLogLineCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ActionLogLineRoleARN != nil {
+		in, out := &in.ActionLogLineRoleARN, &out.ActionLogLineRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActionsEnabled != nil {
+		in, out := &in.ActionsEnabled, &out.ActionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlarmActions != nil {
+		in, out := &in.AlarmActions, &out.AlarmActions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AlarmDescription != nil {
+		in, out := &in.AlarmDescription, &out.AlarmDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.ComparisonOperator != nil {
+		in, out := &in.ComparisonOperator, &out.ComparisonOperator
+		*out = new(string)
+		**out = **in
+	}
+	if in.InsufficientDataActions != nil {
+		in, out := &in.InsufficientDataActions, &out.InsufficientDataActions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(string)
+	if in.OKActions != nil {
+		in, out := &in.OKActions, &out.OKActions
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.QueryResultsToAlarm != nil {
+		in, out := &in.QueryResultsToAlarm, &out.QueryResultsToAlarm
+		*out = new(int64)
+		**out = **in
+	}
+	if in.QueryResultsToEvaluate != nil {
+		in, out := &in.QueryResultsToEvaluate, &out.QueryResultsToEvaluate
+		*out = new(int64)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dimension.
-func (in *Dimension) DeepCopy() *Dimension {
-	if in == nil {
-		return nil
+	if in.ScheduledQueryConfiguration != nil {
+		in, out := &in.ScheduledQueryConfiguration, &out.ScheduledQueryConfiguration
+		*out = new(ScheduledQueryConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(Dimension)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DimensionFilter) DeepCopyInto(out *DimensionFilter) {
-	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(float64)
 		**out = **in
 	}
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
+	if in.TreatMissingData != nil {
+		in, out := &in.TreatMissingData, &out.TreatMissingData
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DimensionFilter.
-func (in *DimensionFilter) DeepCopy() *DimensionFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAlarmSpec.
+func (in *LogAlarmSpec) DeepCopy() *LogAlarmSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DimensionFilter)
+	out := new(LogAlarmSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationCriteria) DeepCopyInto(out *EvaluationCriteria) {
+func (in *LogAlarmStatus) DeepCopyInto(out *LogAlarmStatus) {
 	*out = *in
-	if in.PromQLCriteria != nil {
-		in, out := &in.PromQLCriteria, &out.PromQLCriteria
-		*out = new(AlarmPromQLCriteria)
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationCriteria.
-func (in *EvaluationCriteria) DeepCopy() *EvaluationCriteria {
-	if in == nil {
-		return nil
-	}
-	out := new(EvaluationCriteria)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationWindow) DeepCopyInto(out *EvaluationWindow) {
-	*out = *in
-	if in.SlidingWindow != nil {
-		in, out := &in.SlidingWindow, &out.SlidingWindow
-		*out = make(map[string]*string, len(*in))
-		for key, val := range *in {
-			var outVal *string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = new(string)
-				**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
 			}
-			(*out)[key] = outVal
 		}
 	}
-	if in.WallClockWindow != nil {
-		in, out := &in.WallClockWindow, &out.WallClockWindow
-		*out = new(WallClockWindow)
-		(*in).DeepCopyInto(*out)
+	if in.AlarmConfigurationUpdatedTimestamp != nil {
+		in, out := &in.AlarmConfigurationUpdatedTimestamp, &out.AlarmConfigurationUpdatedTimestamp
+		*out = (*in).DeepCopy()
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationWindow.
-func (in *EvaluationWindow) DeepCopy() *EvaluationWindow {
-	if in == nil {
-		return nil
+	if in.EvaluationState != nil {
+		in, out := &in.EvaluationState, &out.EvaluationState
+		*out = new(string)
+		**out = **in
 	}
-	out := new(EvaluationWindow)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InsightRuleContributorDatapoint) DeepCopyInto(out *InsightRuleContributorDatapoint) {
-	*out = *in
-	if in.Timestamp != nil {
-		in, out := &in.Timestamp, &out.Timestamp
-		*out = (*in).DeepCopy()
+	if in.QueryARN != nil {
+		in, out := &in.QueryARN, &out.QueryARN
+		*out = new(string)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRuleContributorDatapoint.
-func (in *InsightRuleContributorDatapoint) DeepCopy() *InsightRuleContributorDatapoint {
-	if in == nil {
-		return nil
+	if in.StateReason != nil {
+		in, out := &in.StateReason, &out.StateReason
+		*out = new(string)
+		**out = **in
 	}
-	out := new(InsightRuleContributorDatapoint)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InsightRuleMetricDatapoint) DeepCopyInto(out *InsightRuleMetricDatapoint) {
-	*out = *in
-	if in.Timestamp != nil {
-		in, out := &in.Timestamp, &out.Timestamp
+	if in.StateReasonData != nil {
+		in, out := &in.StateReasonData, &out.StateReasonData
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateTransitionedTimestamp != nil {
+		in, out := &in.StateTransitionedTimestamp, &out.StateTransitionedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StateUpdatedTimestamp != nil {
+		in, out := &in.StateUpdatedTimestamp, &out.StateUpdatedTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.StateValue != nil {
+		in, out := &in.StateValue, &out.StateValue
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightRuleMetricDatapoint.
-func (in *InsightRuleMetricDatapoint) DeepCopy() *InsightRuleMetricDatapoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAlarmStatus.
+func (in *LogAlarmStatus) DeepCopy() *LogAlarmStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InsightRuleMetricDatapoint)
+	out := new(LogAlarmStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogAlarm) DeepCopyInto(out *LogAlarm) {
+func (in *LogAlarm_SDK) DeepCopyInto(out *LogAlarm_SDK) {
 	*out = *in
 	if in.ActionLogLineCount != nil {
 		in, out := &in.ActionLogLineCount, &out.ActionLogLineCount
@@ -757,29 +2410,170 @@ func (in *LogAlarm) DeepCopyInto(out *LogAlarm) {
 		in, out := &in.StateUpdatedTimestamp, &out.StateUpdatedTimestamp
 		*out = (*in).DeepCopy()
 	}
-	if in.StateValue != nil {
-		in, out := &in.StateValue, &out.StateValue
+	if in.StateValue != nil {
+		in, out := &in.StateValue, &out.StateValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(float64)
+		**out = **in
+	}
+	if in.TreatMissingData != nil {
+		in, out := &in.TreatMissingData, &out.TreatMissingData
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAlarm_SDK.
+func (in *LogAlarm_SDK) DeepCopy() *LogAlarm_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(LogAlarm_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedInsightRule) DeepCopyInto(out *ManagedInsightRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedInsightRule.
+func (in *ManagedInsightRule) DeepCopy() *ManagedInsightRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedInsightRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedInsightRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedInsightRuleList) DeepCopyInto(out *ManagedInsightRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedInsightRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedInsightRuleList.
+func (in *ManagedInsightRuleList) DeepCopy() *ManagedInsightRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedInsightRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedInsightRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedInsightRuleSpec) DeepCopyInto(out *ManagedInsightRuleSpec) {
+	*out = *in
+	if in.ResourceARN != nil {
+		in, out := &in.ResourceARN, &out.ResourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TemplateName != nil {
+		in, out := &in.TemplateName, &out.TemplateName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Threshold != nil {
-		in, out := &in.Threshold, &out.Threshold
-		*out = new(float64)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedInsightRuleSpec.
+func (in *ManagedInsightRuleSpec) DeepCopy() *ManagedInsightRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedInsightRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedInsightRuleStatus) DeepCopyInto(out *ManagedInsightRuleStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.RuleName != nil {
+		in, out := &in.RuleName, &out.RuleName
+		*out = new(string)
 		**out = **in
 	}
-	if in.TreatMissingData != nil {
-		in, out := &in.TreatMissingData, &out.TreatMissingData
+	if in.RuleState != nil {
+		in, out := &in.RuleState, &out.RuleState
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogAlarm.
-func (in *LogAlarm) DeepCopy() *LogAlarm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedInsightRuleStatus.
+func (in *ManagedInsightRuleStatus) DeepCopy() *ManagedInsightRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LogAlarm)
+	out := new(ManagedInsightRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -803,6 +2597,11 @@ func (in *ManagedRule) DeepCopyInto(out *ManagedRule) {
 			}
 		}
 	}
+	if in.TemplateName != nil {
+		in, out := &in.TemplateName, &out.TemplateName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRule.
@@ -823,6 +2622,16 @@ func (in *ManagedRuleDescription) DeepCopyInto(out *ManagedRuleDescription) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RuleState != nil {
+		in, out := &in.RuleState, &out.RuleState
+		*out = new(ManagedRuleState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateName != nil {
+		in, out := &in.TemplateName, &out.TemplateName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRuleDescription.
@@ -835,6 +2644,31 @@ func (in *ManagedRuleDescription) DeepCopy() *ManagedRuleDescription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedRuleState) DeepCopyInto(out *ManagedRuleState) {
+	*out = *in
+	if in.RuleName != nil {
+		in, out := &in.RuleName, &out.RuleName
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedRuleState.
+func (in *ManagedRuleState) DeepCopy() *ManagedRuleState {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedRuleState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metric) DeepCopyInto(out *Metric) {
 	*out = *in
@@ -938,6 +2772,17 @@ func (in *MetricAlarmSpec) DeepCopyInto(out *MetricAlarmSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AlarmActionRefs != nil {
+		in, out := &in.AlarmActionRefs, &out.AlarmActionRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.AlarmActions != nil {
 		in, out := &in.AlarmActions, &out.AlarmActions
 		*out = make([]*string, len(*in))
@@ -1005,6 +2850,17 @@ func (in *MetricAlarmSpec) DeepCopyInto(out *MetricAlarmSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InsufficientDataActionRefs != nil {
+		in, out := &in.InsufficientDataActionRefs, &out.InsufficientDataActionRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.InsufficientDataActions != nil {
 		in, out := &in.InsufficientDataActions, &out.InsufficientDataActions
 		*out = make([]*string, len(*in))
@@ -1042,6 +2898,17 @@ func (in *MetricAlarmSpec) DeepCopyInto(out *MetricAlarmSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OKActionRefs != nil {
+		in, out := &in.OKActionRefs, &out.OKActionRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.OKActions != nil {
 		in, out := &in.OKActions, &out.OKActions
 		*out = make([]*string, len(*in))
@@ -1089,6 +2956,39 @@ func (in *MetricAlarmSpec) DeepCopyInto(out *MetricAlarmSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TypedAlarmActions != nil {
+		in, out := &in.TypedAlarmActions, &out.TypedAlarmActions
+		*out = make([]*AlarmAction, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlarmAction)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TypedInsufficientDataActions != nil {
+		in, out := &in.TypedInsufficientDataActions, &out.TypedInsufficientDataActions
+		*out = make([]*AlarmAction, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlarmAction)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TypedOKActions != nil {
+		in, out := &in.TypedOKActions, &out.TypedOKActions
+		*out = make([]*AlarmAction, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlarmAction)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Unit != nil {
 		in, out := &in.Unit, &out.Unit
 		*out = new(string)
@@ -1125,6 +3025,29 @@ func (in *MetricAlarmStatus) DeepCopyInto(out *MetricAlarmStatus) {
 			}
 		}
 	}
+	if in.StateReason != nil {
+		in, out := &in.StateReason, &out.StateReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateReasonData != nil {
+		in, out := &in.StateReasonData, &out.StateReasonData
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateTransitionedTimestamp != nil {
+		in, out := &in.StateTransitionedTimestamp, &out.StateTransitionedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StateUpdatedTimestamp != nil {
+		in, out := &in.StateUpdatedTimestamp, &out.StateUpdatedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StateValue != nil {
+		in, out := &in.StateValue, &out.StateValue
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricAlarmStatus.
@@ -1339,6 +3262,26 @@ func (in *MetricAlarm_SDK) DeepCopy() *MetricAlarm_SDK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricCharacteristics) DeepCopyInto(out *MetricCharacteristics) {
+	*out = *in
+	if in.PeriodicSpikes != nil {
+		in, out := &in.PeriodicSpikes, &out.PeriodicSpikes
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricCharacteristics.
+func (in *MetricCharacteristics) DeepCopy() *MetricCharacteristics {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricCharacteristics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricDataQuery) DeepCopyInto(out *MetricDataQuery) {
 	*out = *in
@@ -1713,6 +3656,11 @@ func (in *MetricStreamSpec) DeepCopyInto(out *MetricStreamSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
 	if in.StatisticsConfigurations != nil {
 		in, out := &in.StatisticsConfigurations, &out.StatisticsConfigurations
 		*out = make([]*MetricStreamStatisticsConfiguration, len(*in))
@@ -1828,6 +3776,19 @@ func (in *MetricStreamStatus) DeepCopyInto(out *MetricStreamStatus) {
 			}
 		}
 	}
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUpdateDate != nil {
+		in, out := &in.LastUpdateDate, &out.LastUpdateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.StreamState != nil {
+		in, out := &in.StreamState, &out.StreamState
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricStreamStatus.
@@ -1840,6 +3801,68 @@ func (in *MetricStreamStatus) DeepCopy() *MetricStreamStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MuteTargets) DeepCopyInto(out *MuteTargets) {
+	*out = *in
+	if in.AlarmNames != nil {
+		in, out := &in.AlarmNames, &out.AlarmNames
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AlarmRefs != nil {
+		in, out := &in.AlarmRefs, &out.AlarmRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MuteTargets.
+func (in *MuteTargets) DeepCopy() *MuteTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(MuteTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsItemAlarmAction) DeepCopyInto(out *OpsItemAlarmAction) {
+	*out = *in
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(string)
+		**out = **in
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsItemAlarmAction.
+func (in *OpsItemAlarmAction) DeepCopy() *OpsItemAlarmAction {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsItemAlarmAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Range) DeepCopyInto(out *Range) {
 	*out = *in
@@ -1863,9 +3886,59 @@ func (in *Range) DeepCopy() *Range {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePlanAlarmAction) DeepCopyInto(out *ResponsePlanAlarmAction) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePlanAlarmAction.
+func (in *ResponsePlanAlarmAction) DeepCopy() *ResponsePlanAlarmAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponsePlanAlarmAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
 	if in.Timezone != nil {
 		in, out := &in.Timezone, &out.Timezone
 		*out = new(string)
@@ -1932,6 +4005,17 @@ func (in *ScheduledQueryConfiguration) DeepCopyInto(out *ScheduledQueryConfigura
 			}
 		}
 	}
+	if in.LogGroupRefs != nil {
+		in, out := &in.LogGroupRefs, &out.LogGroupRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.QueryARN != nil {
 		in, out := &in.QueryARN, &out.QueryARN
 		*out = new(string)
@@ -1952,6 +4036,11 @@ func (in *ScheduledQueryConfiguration) DeepCopyInto(out *ScheduledQueryConfigura
 		*out = new(string)
 		**out = **in
 	}
+	if in.ScheduledQueryRoleRef != nil {
+		in, out := &in.ScheduledQueryRoleRef, &out.ScheduledQueryRoleRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -2004,6 +4093,11 @@ func (in *SingleMetricAnomalyDetector) DeepCopyInto(out *SingleMetricAnomalyDete
 		*out = new(string)
 		**out = **in
 	}
+	if in.Stat != nil {
+		in, out := &in.Stat, &out.Stat
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleMetricAnomalyDetector.
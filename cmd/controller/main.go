@@ -21,6 +21,8 @@ import (
 	goruntime "runtime"
 	"runtime/debug"
 
+	cloudwatchlogsapitypes "github.com/aws-controllers-k8s/cloudwatchlogs-controller/apis/v1alpha1"
+	dynamodbapitypes "github.com/aws-controllers-k8s/dynamodb-controller/apis/v1alpha1"
 	firehoseapitypes "github.com/aws-controllers-k8s/firehose-controller/apis/v1alpha1"
 	iamapitypes "github.com/aws-controllers-k8s/iam-controller/apis/v1alpha1"
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
@@ -29,6 +31,7 @@ import (
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 	ackrtutil "github.com/aws-controllers-k8s/runtime/pkg/util"
 	ackrtwebhook "github.com/aws-controllers-k8s/runtime/pkg/webhook"
+	snsapitypes "github.com/aws-controllers-k8s/sns-controller/apis/v1alpha1"
 	flag "github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -41,11 +44,19 @@ import (
 	ctrlrtwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	svctypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/cloudwatch-controller/pkg/drift"
 	svcresource "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource"
 
-	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/dashboard"
-	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/metric_alarm"
+	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/alarm_mute_rule"
+	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/anomaly_detector"
+	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/composite_alarm"
+	dashboardresource "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/dashboard"
+	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/insight_rule"
+	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/log_alarm"
+	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/managed_insight_rule"
+	metricalarmresource "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/metric_alarm"
 	_ "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/resource/metric_stream"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
 
 	"github.com/aws-controllers-k8s/cloudwatch-controller/pkg/version"
 )
@@ -77,8 +88,11 @@ func init() {
 
 	_ = svctypes.AddToScheme(scheme)
 	_ = ackv1alpha1.AddToScheme(scheme)
+	_ = cloudwatchlogsapitypes.AddToScheme(scheme)
+	_ = dynamodbapitypes.AddToScheme(scheme)
 	_ = firehoseapitypes.AddToScheme(scheme)
 	_ = iamapitypes.AddToScheme(scheme)
+	_ = snsapitypes.AddToScheme(scheme)
 }
 
 func main() {
@@ -188,7 +202,7 @@ func main() {
 	).WithLogger(
 		ctrlrt.Log,
 	).WithResourceManagerFactories(
-		svcresource.GetManagerFactories(),
+		managerFactories,
 	).WithPrometheusRegistry(
 		ctrlrtmetrics.Registry,
 	)
@@ -205,7 +219,8 @@ func main() {
 		}
 	}
 
-	if err = sc.BindControllerManager(mgr, ackCfg); err != nil {
+	controllers := commonutil.NewControllerRecorder(mgr)
+	if err = sc.BindControllerManager(controllers, ackCfg); err != nil {
 		setupLog.Error(
 			err, "unable bind to controller manager to service controller",
 			"aws.service", awsServiceAlias,
@@ -213,6 +228,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = dashboardresource.SetupConfigMapWatcher(
+		mgr, controllers.Controller(dashboardresource.GroupKind.Kind),
+	); err != nil {
+		setupLog.Error(
+			err, "unable to set up the dashboard configmap watcher",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+
+	if err = metricalarmresource.SetupAlarmSnapshot(mgr, sc); err != nil {
+		setupLog.Error(
+			err, "unable to set up the metric alarm snapshot",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+
+	drift.Setup(mgr)
+
 	if err = mgr.AddHealthzCheck("health", ctrlrthealthz.Ping); err != nil {
 		setupLog.Error(
 			err, "unable to set up health check",
@@ -72,7 +72,13 @@ rules:
 - apiGroups:
   - cloudwatch.services.k8s.aws
   resources:
+  - alarmmuterules
+  - anomalydetectors
+  - compositealarms
   - dashboards
+  - insightrules
+  - logalarms
+  - managedinsightrules
   - metricalarms
   - metricstreams
   verbs:
@@ -86,13 +92,42 @@ rules:
 - apiGroups:
   - cloudwatch.services.k8s.aws
   resources:
+  - alarmmuterules/status
+  - anomalydetectors/status
+  - compositealarms/status
   - dashboards/status
+  - insightrules/status
+  - logalarms/status
+  - managedinsightrules/status
   - metricalarms/status
   - metricstreams/status
   verbs:
   - get
   - patch
   - update
+- apiGroups:
+  - cloudwatchlogs.services.k8s.aws
+  resources:
+  - loggroups
+  - loggroups/status
+  verbs:
+  - get
+  - list
+- apiGroups:
+  - dynamodb.services.k8s.aws
+  resources:
+  - tables
+  - tables/status
+  verbs:
+  - get
+  - list
+- apiGroups:
+  - events.k8s.io
+  resources:
+  - events
+  verbs:
+  - create
+  - patch
 - apiGroups:
   - firehose.services.k8s.aws
   resources:
@@ -131,6 +166,14 @@ rules:
   - get
   - patch
   - update
+- apiGroups:
+  - sns.services.k8s.aws
+  resources:
+  - topics
+  - topics/status
+  verbs:
+  - get
+  - list
 {{- end }}
 
 {{/* Convert k/v map to string like: "key1=value1,key2=value2,..." */}}
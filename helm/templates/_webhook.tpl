@@ -0,0 +1,14 @@
+{{/* The name of the Service in front of the webhook server */}}
+{{- define "ack-cloudwatch-controller.webhook.service-name" -}}
+{{- printf "%s-webhook" (include "ack-cloudwatch-controller.app.fullname" .) | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{/* The name of the Secret holding the serving certificate of the webhook server */}}
+{{- define "ack-cloudwatch-controller.webhook.cert-secret-name" -}}
+{{- printf "%s-webhook-cert" (include "ack-cloudwatch-controller.app.fullname" .) | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{/* The mount path of the serving certificate of the webhook server */}}
+{{- define "ack-cloudwatch-controller.webhook.cert-mount-path" -}}
+{{- "/tmp/k8s-webhook-server/serving-certs" -}}
+{{- end -}}
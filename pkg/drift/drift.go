@@ -0,0 +1,149 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package drift detects the changes made to CloudWatch resources outside of
+// the controller. The resources are read back every
+// requeue_on_success_seconds, see generator.yaml.
+package drift
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	ctrlrtmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+const (
+	// EventReasonDriftDetected is the reason of the event emitted when a
+	// resource was changed outside of the controller.
+	EventReasonDriftDetected = "DriftDetected"
+	// eventActionResync is the action of the DriftDetected event.
+	eventActionResync = "Resync"
+)
+
+var detectedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "ack_cloudwatch_drift_detected_total",
+		Help: "Total number of changes made to CloudWatch resources outside of the controller.",
+	},
+	[]string{
+		"kind",
+	},
+)
+
+func init() {
+	ctrlrtmetrics.Registry.MustRegister(detectedTotal)
+}
+
+var (
+	mu sync.Mutex
+	// observed holds, by kind and namespace/name, the state of each resource
+	// the last time it was read from CloudWatch.
+	observed = map[string]acktypes.AWSResource{}
+	// recorder emits the DriftDetected events. Drift is only counted until
+	// SetEventRecorder is called.
+	recorder events.EventRecorder
+)
+
+// Setup registers with the supplied manager the recorder of the
+// DriftDetected events.
+func Setup(mgr ctrlrt.Manager) {
+	SetEventRecorder(mgr.GetEventRecorder("ack-cloudwatch-drift"))
+}
+
+// SetEventRecorder sets the recorder used to emit the DriftDetected events.
+func SetEventRecorder(r events.EventRecorder) {
+	mu.Lock()
+	defer mu.Unlock()
+	recorder = r
+}
+
+// Observe compares the supplied resource, just read from CloudWatch, with
+// the state read the previous time and reports every difference as drift,
+// with a DriftDetected event and the ack_cloudwatch_drift_detected_total
+// counter. It then records the resource as the last state read.
+//
+// Differences are only reported when the generation of the resource did not
+// change in between, since the controller reads the resources with the spec
+// of the generation being reconciled. The controller must call Forget
+// before changing a resource in CloudWatch. Nothing is reported the first
+// time a resource is read after the controller starts.
+func Observe(rd acktypes.AWSResourceDescriptor, latest acktypes.AWSResource) {
+	kind := rd.GroupVersionKind().Kind
+	k := key(kind, latest)
+	mu.Lock()
+	previous := observed[k]
+	observed[k] = latest.DeepCopy()
+	r := recorder
+	mu.Unlock()
+
+	if previous == nil || previous.MetaObject().GetGeneration() != latest.MetaObject().GetGeneration() {
+		return
+	}
+	delta := rd.Delta(previous, latest)
+	if len(delta.Differences) == 0 {
+		return
+	}
+	detectedTotal.WithLabelValues(kind).Inc()
+	if r != nil {
+		r.Eventf(
+			latest.RuntimeObject(), nil, corev1.EventTypeWarning,
+			EventReasonDriftDetected, eventActionResync,
+			"%s changed outside of the controller at %s",
+			kind, strings.Join(differentPaths(delta), ", "),
+		)
+	}
+}
+
+// Forget drops the state recorded by Observe for the supplied resource. It
+// is called before the controller changes or deletes the resource in
+// CloudWatch, so that the change is not reported as drift.
+func Forget(rd acktypes.AWSResourceDescriptor, r acktypes.AWSResource) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(observed, key(rd.GroupVersionKind().Kind, r))
+}
+
+// key returns the key of the supplied resource in observed.
+func key(kind string, r acktypes.AWSResource) string {
+	meta := r.MetaObject()
+	return kind + "/" + meta.GetNamespace() + "/" + meta.GetName()
+}
+
+// differentPaths returns the dotted paths of the differences in the
+// supplied delta.
+func differentPaths(delta *ackcompare.Delta) []string {
+	paths := make([]string, 0, len(delta.Differences))
+	for _, d := range delta.Differences {
+		// ackcompare.Path only exposes its parts through its JSON encoding.
+		b, err := json.Marshal(d.Path)
+		if err != nil {
+			continue
+		}
+		var p struct{ Parts []string }
+		if err := json.Unmarshal(b, &p); err != nil {
+			continue
+		}
+		paths = append(paths, strings.Join(p.Parts, "."))
+	}
+	return paths
+}
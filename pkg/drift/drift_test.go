@@ -0,0 +1,130 @@
+package drift
+
+import (
+	"reflect"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fakeResource wraps a ConfigMap, whose Data stands for the state read from
+// CloudWatch.
+type fakeResource struct {
+	acktypes.AWSResource
+	cm *corev1.ConfigMap
+}
+
+func (r *fakeResource) MetaObject() metav1.Object      { return r.cm }
+func (r *fakeResource) RuntimeObject() client.Object   { return r.cm }
+func (r *fakeResource) DeepCopy() acktypes.AWSResource { return &fakeResource{cm: r.cm.DeepCopy()} }
+
+// fakeDescriptor compares the Data of fakeResources.
+type fakeDescriptor struct {
+	acktypes.AWSResourceDescriptor
+}
+
+func (fakeDescriptor) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Kind: "FakeKind"}
+}
+
+func (fakeDescriptor) Delta(a, b acktypes.AWSResource) *ackcompare.Delta {
+	delta := ackcompare.NewDelta()
+	ad, bd := a.(*fakeResource).cm.Data, b.(*fakeResource).cm.Data
+	for k := range ad {
+		if ad[k] != bd[k] {
+			delta.Add("Spec."+k, ad[k], bd[k])
+		}
+	}
+	return delta
+}
+
+func newFakeResource(generation int64, data map[string]string) *fakeResource {
+	return &fakeResource{cm: &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "res", Generation: generation},
+		Data:       data,
+	}}
+}
+
+func TestObserve(t *testing.T) {
+	rd := fakeDescriptor{}
+	tests := []struct {
+		name   string
+		forget bool
+		reads  []*fakeResource
+		events []string
+	}{
+		{
+			name: "first read",
+			reads: []*fakeResource{
+				newFakeResource(1, map[string]string{"Threshold": "1"}),
+			},
+		},
+		{
+			name: "unchanged",
+			reads: []*fakeResource{
+				newFakeResource(1, map[string]string{"Threshold": "1"}),
+				newFakeResource(1, map[string]string{"Threshold": "1"}),
+			},
+		},
+		{
+			name: "changed outside of the controller",
+			reads: []*fakeResource{
+				newFakeResource(1, map[string]string{"Threshold": "1", "Period": "60"}),
+				newFakeResource(1, map[string]string{"Threshold": "2", "Period": "60"}),
+				newFakeResource(1, map[string]string{"Threshold": "2", "Period": "60"}),
+			},
+			events: []string{"Warning DriftDetected FakeKind changed outside of the controller at Spec.Threshold"},
+		},
+		{
+			name: "spec changed in between",
+			reads: []*fakeResource{
+				newFakeResource(1, map[string]string{"Threshold": "1"}),
+				newFakeResource(2, map[string]string{"Threshold": "2"}),
+			},
+		},
+		{
+			name:   "changed by the controller",
+			forget: true,
+			reads: []*fakeResource{
+				newFakeResource(1, map[string]string{"Threshold": "1"}),
+				newFakeResource(1, map[string]string{"Threshold": "2"}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := events.NewFakeRecorder(10)
+			SetEventRecorder(recorder)
+			defer SetEventRecorder(nil)
+			defer Forget(rd, tt.reads[0])
+			counter := detectedTotal.WithLabelValues("FakeKind")
+			before := testutil.ToFloat64(counter)
+
+			for i, r := range tt.reads {
+				if tt.forget && i > 0 {
+					Forget(rd, r)
+				}
+				Observe(rd, r)
+			}
+
+			close(recorder.Events)
+			var got []string
+			for e := range recorder.Events {
+				got = append(got, e)
+			}
+			if !reflect.DeepEqual(got, tt.events) {
+				t.Errorf("events = %q, want %q", got, tt.events)
+			}
+			if inc := testutil.ToFloat64(counter) - before; inc != float64(len(tt.events)) {
+				t.Errorf("counter increased by %v, want %d", inc, len(tt.events))
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package alarm_mute_rule
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func alarmRef(name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	return &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+}
+
+func metricAlarm(name, alarmName string, synced corev1.ConditionStatus) *svcapitypes.MetricAlarm {
+	return &svcapitypes.MetricAlarm{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       svcapitypes.MetricAlarmSpec{Name: aws.String(alarmName)},
+		Status: svcapitypes.MetricAlarmStatus{
+			Conditions: []*ackv1alpha1.Condition{{
+				Type:   ackv1alpha1.ConditionTypeResourceSynced,
+				Status: synced,
+			}},
+		},
+	}
+}
+
+func TestResolveReferenceForMuteTargets_AlarmNames(t *testing.T) {
+	tests := []struct {
+		name           string
+		targets        *svcapitypes.MuteTargets
+		wantReferences bool
+		wantNames      []string
+		wantErr        bool
+	}{
+		{
+			name: "no mute targets",
+		},
+		{
+			name:      "alarm names",
+			targets:   &svcapitypes.MuteTargets{AlarmNames: []*string{aws.String("prod-cpu-high")}},
+			wantNames: []string{"prod-cpu-high"},
+		},
+		{
+			name: "alarm references",
+			targets: &svcapitypes.MuteTargets{
+				AlarmRefs: []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("cpu"), alarmRef("latency")},
+			},
+			wantReferences: true,
+			wantNames:      []string{"prod-cpu-high", "prod-latency-high"},
+		},
+		{
+			name: "alarm not synced",
+			targets: &svcapitypes.MuteTargets{
+				AlarmRefs: []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("pending")},
+			},
+			wantReferences: true,
+			wantErr:        true,
+		},
+		{
+			name: "missing alarm",
+			targets: &svcapitypes.MuteTargets{
+				AlarmRefs: []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("missing")},
+			},
+			wantReferences: true,
+			wantErr:        true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	apiReader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		metricAlarm("cpu", "prod-cpu-high", corev1.ConditionTrue),
+		metricAlarm("latency", "prod-latency-high", corev1.ConditionTrue),
+		metricAlarm("pending", "prod-pending", corev1.ConditionFalse),
+	).Build()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := &resourceManager{cfg: ackcfg.Config{}}
+			ko := &svcapitypes.AlarmMuteRule{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "release-window"},
+				Spec:       svcapitypes.AlarmMuteRuleSpec{MuteTargets: tt.targets},
+			}
+
+			hasReferences, err := rm.resolveReferenceForMuteTargets_AlarmNames(context.TODO(), apiReader, ko)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveReferenceForMuteTargets_AlarmNames() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if hasReferences != tt.wantReferences {
+				t.Errorf("resolveReferenceForMuteTargets_AlarmNames() hasReferences = %v, want %v", hasReferences, tt.wantReferences)
+			}
+			if tt.wantErr {
+				return
+			}
+			var got []string
+			if ko.Spec.MuteTargets != nil {
+				got = aws.ToStringSlice(ko.Spec.MuteTargets.AlarmNames)
+			}
+			if len(got) != len(tt.wantNames) {
+				t.Fatalf("resolveReferenceForMuteTargets_AlarmNames() AlarmNames = %v, want %v", got, tt.wantNames)
+			}
+			for i := range got {
+				if got[i] != tt.wantNames[i] {
+					t.Errorf("resolveReferenceForMuteTargets_AlarmNames() AlarmNames = %v, want %v", got, tt.wantNames)
+				}
+			}
+		})
+	}
+}
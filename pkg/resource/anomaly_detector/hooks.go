@@ -0,0 +1,356 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package anomaly_detector
+
+import (
+	"context"
+	"errors"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// errInvalidDetectorType is returned when the spec doesn't describe exactly
+// one kind of anomaly detector.
+var errInvalidDetectorType = ackerr.NewTerminalError(errors.New(
+	"exactly one of singleMetricAnomalyDetector or metricMathAnomalyDetector must be set",
+))
+
+// validateAnomalyDetector returns a terminal error unless exactly one of
+// SingleMetricAnomalyDetector and MetricMathAnomalyDetector is set.
+func validateAnomalyDetector(r *resource) error {
+	if (r.ko.Spec.SingleMetricAnomalyDetector == nil) == (r.ko.Spec.MetricMathAnomalyDetector == nil) {
+		return errInvalidDetectorType
+	}
+	return nil
+}
+
+// customFindAnomalyDetector reads the anomaly detector with
+// DescribeAnomalyDetectors. Anomaly detectors have no name, so the detectors
+// are listed and the one with the same metric or metric math expression as
+// the spec is returned. Adopted resources that only know the detector ID are
+// looked up by that ID instead.
+func (rm *resourceManager) customFindAnomalyDetector(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindAnomalyDetector")
+	defer func() {
+		exit(err)
+	}()
+
+	input := &svcsdk.DescribeAnomalyDetectorsInput{}
+	switch {
+	case r.ko.Spec.SingleMetricAnomalyDetector != nil:
+		single := r.ko.Spec.SingleMetricAnomalyDetector
+		input.AnomalyDetectorTypes = []svcsdktypes.AnomalyDetectorType{svcsdktypes.AnomalyDetectorTypeSingleMetric}
+		input.MetricName = single.MetricName
+		input.Namespace = single.Namespace
+		input.Dimensions = sdkDimensions(single.Dimensions)
+	case r.ko.Spec.MetricMathAnomalyDetector != nil:
+		input.AnomalyDetectorTypes = []svcsdktypes.AnomalyDetectorType{svcsdktypes.AnomalyDetectorTypeMetricMath}
+	case r.ko.Status.AnomalyDetectorID != nil:
+		input.AnomalyDetectorIds = []string{*r.ko.Status.AnomalyDetectorID}
+	default:
+		// If neither detector is set the AWS resource can't have been created
+		// yet. Return NotFound here to indicate to callers that the resource
+		// isn't yet created.
+		return nil, ackerr.NotFound
+	}
+
+	var found *svcsdktypes.AnomalyDetector
+	for found == nil {
+		var resp *svcsdk.DescribeAnomalyDetectorsOutput
+		resp, err = rm.sdkapi.DescribeAnomalyDetectors(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeAnomalyDetectors", err)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.AnomalyDetectors {
+			if anomalyDetectorMatches(r.ko, &resp.AnomalyDetectors[i]) {
+				found = &resp.AnomalyDetectors[i]
+				break
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	if found == nil {
+		return nil, ackerr.NotFound
+	}
+
+	// Merge in the information we read from the API call above to the copy of
+	// the original Kubernetes object we passed to the function
+	ko := r.ko.DeepCopy()
+	setResourceFromAnomalyDetector(ko, found, r.ko)
+
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// setResourceFromAnomalyDetector copies the detector returned by
+// DescribeAnomalyDetectors into ko. The detector definition in the spec was
+// used to find the detector, so it is only read back for adopted resources
+// that don't have one yet. Empty configuration returned by CloudWatch for
+// fields that aren't set in desired is dropped so that it doesn't show up as
+// a difference.
+func setResourceFromAnomalyDetector(
+	ko *svcapitypes.AnomalyDetector,
+	elem *svcsdktypes.AnomalyDetector,
+	desired *svcapitypes.AnomalyDetector,
+) {
+	ko.Status.AnomalyDetectorID = elem.AnomalyDetectorId
+	if elem.StateValue != "" {
+		ko.Status.StateValue = aws.String(string(elem.StateValue))
+	} else {
+		ko.Status.StateValue = nil
+	}
+
+	ko.Spec.Configuration = newConfiguration(elem.Configuration)
+	if desired.Spec.Configuration == nil && ko.Spec.Configuration != nil &&
+		len(ko.Spec.Configuration.ExcludedTimeRanges) == 0 &&
+		ko.Spec.Configuration.MetricTimezone == nil {
+		ko.Spec.Configuration = nil
+	}
+
+	ko.Spec.MetricCharacteristics = nil
+	if elem.MetricCharacteristics != nil {
+		ko.Spec.MetricCharacteristics = &svcapitypes.MetricCharacteristics{
+			PeriodicSpikes: elem.MetricCharacteristics.PeriodicSpikes,
+		}
+		if desired.Spec.MetricCharacteristics == nil &&
+			!aws.ToBool(elem.MetricCharacteristics.PeriodicSpikes) {
+			ko.Spec.MetricCharacteristics = nil
+		}
+	}
+
+	if desired.Spec.SingleMetricAnomalyDetector == nil && desired.Spec.MetricMathAnomalyDetector == nil {
+		ko.Spec.SingleMetricAnomalyDetector = newSingleMetricAnomalyDetector(elem.SingleMetricAnomalyDetector)
+		ko.Spec.MetricMathAnomalyDetector = newMetricMathAnomalyDetector(elem.MetricMathAnomalyDetector)
+	}
+}
+
+// anomalyDetectorMatches returns true if elem is the detector described by
+// the spec of ko, or the detector with the ID in ko's status when the spec
+// doesn't describe one.
+func anomalyDetectorMatches(
+	ko *svcapitypes.AnomalyDetector,
+	elem *svcsdktypes.AnomalyDetector,
+) bool {
+	switch {
+	case ko.Spec.SingleMetricAnomalyDetector != nil:
+		return elem.SingleMetricAnomalyDetector != nil &&
+			singleMetricEqual(ko.Spec.SingleMetricAnomalyDetector, elem.SingleMetricAnomalyDetector)
+	case ko.Spec.MetricMathAnomalyDetector != nil:
+		return elem.MetricMathAnomalyDetector != nil &&
+			metricDataQueriesEqual(ko.Spec.MetricMathAnomalyDetector.MetricDataQueries, elem.MetricMathAnomalyDetector.MetricDataQueries)
+	case ko.Status.AnomalyDetectorID != nil:
+		return aws.ToString(elem.AnomalyDetectorId) == *ko.Status.AnomalyDetectorID
+	}
+	return false
+}
+
+// singleMetricEqual returns true if a and b designate the same metric and
+// statistic. The account ID is only compared when it is set in a, since
+// CloudWatch fills it in for detectors in the caller's account.
+func singleMetricEqual(
+	a *svcapitypes.SingleMetricAnomalyDetector,
+	b *svcsdktypes.SingleMetricAnomalyDetector,
+) bool {
+	if a.AccountID != nil && *a.AccountID != aws.ToString(b.AccountId) {
+		return false
+	}
+	return aws.ToString(a.Namespace) == aws.ToString(b.Namespace) &&
+		aws.ToString(a.MetricName) == aws.ToString(b.MetricName) &&
+		aws.ToString(a.Stat) == aws.ToString(b.Stat) &&
+		dimensionsEqual(a.Dimensions, b.Dimensions)
+}
+
+// metricDataQueriesEqual returns true if a and b describe the same metric
+// math expression. Labels and ReturnData don't change the time series the
+// detector is trained on and are ignored.
+func metricDataQueriesEqual(
+	a []*svcapitypes.MetricDataQuery,
+	b []svcsdktypes.MetricDataQuery,
+) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, qa := range a {
+		qb := b[i]
+		if aws.ToString(qa.ID) != aws.ToString(qb.Id) ||
+			aws.ToString(qa.Expression) != aws.ToString(qb.Expression) ||
+			aws.ToString(qa.AccountID) != aws.ToString(qb.AccountId) ||
+			aws.ToInt64(qa.Period) != int64(aws.ToInt32(qb.Period)) {
+			return false
+		}
+		if (qa.MetricStat == nil) != (qb.MetricStat == nil) {
+			return false
+		}
+		if qa.MetricStat == nil {
+			continue
+		}
+		sa, sb := qa.MetricStat, qb.MetricStat
+		if aws.ToString(sa.Stat) != aws.ToString(sb.Stat) ||
+			aws.ToInt64(sa.Period) != int64(aws.ToInt32(sb.Period)) ||
+			aws.ToString(sa.Unit) != string(sb.Unit) {
+			return false
+		}
+		if (sa.Metric == nil) != (sb.Metric == nil) {
+			return false
+		}
+		if sa.Metric != nil && (aws.ToString(sa.Metric.Namespace) != aws.ToString(sb.Metric.Namespace) ||
+			aws.ToString(sa.Metric.MetricName) != aws.ToString(sb.Metric.MetricName) ||
+			!dimensionsEqual(sa.Metric.Dimensions, sb.Metric.Dimensions)) {
+			return false
+		}
+	}
+	return true
+}
+
+// dimensionsEqual returns true if a and b contain the same dimensions,
+// regardless of their order.
+func dimensionsEqual(a []*svcapitypes.Dimension, b []svcsdktypes.Dimension) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	values := make(map[string]string, len(a))
+	for _, d := range a {
+		values[aws.ToString(d.Name)] = aws.ToString(d.Value)
+	}
+	for _, d := range b {
+		value, ok := values[aws.ToString(d.Name)]
+		if !ok || value != aws.ToString(d.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// sdkDimensions returns the SDK representation of the supplied dimensions.
+func sdkDimensions(dims []*svcapitypes.Dimension) []svcsdktypes.Dimension {
+	if dims == nil {
+		return nil
+	}
+	res := make([]svcsdktypes.Dimension, 0, len(dims))
+	for _, d := range dims {
+		res = append(res, svcsdktypes.Dimension{Name: d.Name, Value: d.Value})
+	}
+	return res
+}
+
+// newDimensions returns the CRD representation of the supplied dimensions.
+func newDimensions(dims []svcsdktypes.Dimension) []*svcapitypes.Dimension {
+	if dims == nil {
+		return nil
+	}
+	res := make([]*svcapitypes.Dimension, 0, len(dims))
+	for _, d := range dims {
+		res = append(res, &svcapitypes.Dimension{Name: d.Name, Value: d.Value})
+	}
+	return res
+}
+
+// newConfiguration returns the CRD representation of the supplied detector
+// configuration.
+func newConfiguration(
+	cfg *svcsdktypes.AnomalyDetectorConfiguration,
+) *svcapitypes.AnomalyDetectorConfiguration {
+	if cfg == nil {
+		return nil
+	}
+	res := &svcapitypes.AnomalyDetectorConfiguration{
+		MetricTimezone: cfg.MetricTimezone,
+	}
+	for _, rng := range cfg.ExcludedTimeRanges {
+		elem := &svcapitypes.Range{}
+		if rng.StartTime != nil {
+			elem.StartTime = &metav1.Time{Time: *rng.StartTime}
+		}
+		if rng.EndTime != nil {
+			elem.EndTime = &metav1.Time{Time: *rng.EndTime}
+		}
+		res.ExcludedTimeRanges = append(res.ExcludedTimeRanges, elem)
+	}
+	return res
+}
+
+// newSingleMetricAnomalyDetector returns the CRD representation of the
+// supplied single metric detector.
+func newSingleMetricAnomalyDetector(
+	single *svcsdktypes.SingleMetricAnomalyDetector,
+) *svcapitypes.SingleMetricAnomalyDetector {
+	if single == nil {
+		return nil
+	}
+	return &svcapitypes.SingleMetricAnomalyDetector{
+		AccountID:  single.AccountId,
+		Dimensions: newDimensions(single.Dimensions),
+		MetricName: single.MetricName,
+		Namespace:  single.Namespace,
+		Stat:       single.Stat,
+	}
+}
+
+// newMetricMathAnomalyDetector returns the CRD representation of the supplied
+// metric math detector.
+func newMetricMathAnomalyDetector(
+	detector *svcsdktypes.MetricMathAnomalyDetector,
+) *svcapitypes.MetricMathAnomalyDetector {
+	if detector == nil {
+		return nil
+	}
+	res := &svcapitypes.MetricMathAnomalyDetector{}
+	for _, q := range detector.MetricDataQueries {
+		elem := &svcapitypes.MetricDataQuery{
+			AccountID:  q.AccountId,
+			Expression: q.Expression,
+			ID:         q.Id,
+			Label:      q.Label,
+			ReturnData: q.ReturnData,
+		}
+		if q.Period != nil {
+			elem.Period = aws.Int64(int64(*q.Period))
+		}
+		if q.MetricStat != nil {
+			elem.MetricStat = &svcapitypes.MetricStat{
+				Stat: q.MetricStat.Stat,
+			}
+			if q.MetricStat.Period != nil {
+				elem.MetricStat.Period = aws.Int64(int64(*q.MetricStat.Period))
+			}
+			if q.MetricStat.Unit != "" {
+				elem.MetricStat.Unit = aws.String(string(q.MetricStat.Unit))
+			}
+			if q.MetricStat.Metric != nil {
+				elem.MetricStat.Metric = &svcapitypes.Metric{
+					Dimensions: newDimensions(q.MetricStat.Metric.Dimensions),
+					MetricName: q.MetricStat.Metric.MetricName,
+					Namespace:  q.MetricStat.Metric.Namespace,
+				}
+			}
+		}
+		res.MetricDataQueries = append(res.MetricDataQueries, elem)
+	}
+	return res
+}
@@ -0,0 +1,280 @@
+package anomaly_detector
+
+import (
+	"testing"
+	"time"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func singleMetricDetector(accountID *string, dims ...*svcapitypes.Dimension) *svcapitypes.AnomalyDetector {
+	return &svcapitypes.AnomalyDetector{
+		Spec: svcapitypes.AnomalyDetectorSpec{
+			SingleMetricAnomalyDetector: &svcapitypes.SingleMetricAnomalyDetector{
+				AccountID:  accountID,
+				Dimensions: dims,
+				MetricName: aws.String("CPUUtilization"),
+				Namespace:  aws.String("AWS/EC2"),
+				Stat:       aws.String("Average"),
+			},
+		},
+	}
+}
+
+func mathDetector(expression string) *svcapitypes.AnomalyDetector {
+	return &svcapitypes.AnomalyDetector{
+		Spec: svcapitypes.AnomalyDetectorSpec{
+			MetricMathAnomalyDetector: &svcapitypes.MetricMathAnomalyDetector{
+				MetricDataQueries: []*svcapitypes.MetricDataQuery{
+					{
+						ID: aws.String("m1"),
+						MetricStat: &svcapitypes.MetricStat{
+							Metric: &svcapitypes.Metric{
+								MetricName: aws.String("Invocations"),
+								Namespace:  aws.String("AWS/Lambda"),
+							},
+							Period: aws.Int64(300),
+							Stat:   aws.String("Sum"),
+						},
+						ReturnData: aws.Bool(false),
+					},
+					{
+						Expression: aws.String(expression),
+						ID:         aws.String("e1"),
+						ReturnData: aws.Bool(true),
+					},
+				},
+			},
+		},
+	}
+}
+
+func sdkMathDetector(expression string) *svcsdktypes.MetricMathAnomalyDetector {
+	return &svcsdktypes.MetricMathAnomalyDetector{
+		MetricDataQueries: []svcsdktypes.MetricDataQuery{
+			{
+				Id: aws.String("m1"),
+				MetricStat: &svcsdktypes.MetricStat{
+					Metric: &svcsdktypes.Metric{
+						MetricName: aws.String("Invocations"),
+						Namespace:  aws.String("AWS/Lambda"),
+					},
+					Period: aws.Int32(300),
+					Stat:   aws.String("Sum"),
+				},
+			},
+			{
+				Expression: aws.String(expression),
+				Id:         aws.String("e1"),
+				Label:      aws.String("Invocations per minute"),
+			},
+		},
+	}
+}
+
+func TestAnomalyDetectorMatches(t *testing.T) {
+	instance := &svcapitypes.Dimension{Name: aws.String("InstanceId"), Value: aws.String("i-0123")}
+	asg := &svcapitypes.Dimension{Name: aws.String("AutoScalingGroupName"), Value: aws.String("web")}
+	sdkSingle := &svcsdktypes.SingleMetricAnomalyDetector{
+		AccountId: aws.String("111111111111"),
+		Dimensions: []svcsdktypes.Dimension{
+			{Name: aws.String("AutoScalingGroupName"), Value: aws.String("web")},
+			{Name: aws.String("InstanceId"), Value: aws.String("i-0123")},
+		},
+		MetricName: aws.String("CPUUtilization"),
+		Namespace:  aws.String("AWS/EC2"),
+		Stat:       aws.String("Average"),
+	}
+
+	tests := []struct {
+		name string
+		ko   *svcapitypes.AnomalyDetector
+		elem *svcsdktypes.AnomalyDetector
+		want bool
+	}{
+		{
+			name: "single metric with dimensions in a different order",
+			ko:   singleMetricDetector(nil, instance, asg),
+			elem: &svcsdktypes.AnomalyDetector{SingleMetricAnomalyDetector: sdkSingle},
+			want: true,
+		},
+		{
+			name: "single metric with a different account",
+			ko:   singleMetricDetector(aws.String("222222222222"), instance, asg),
+			elem: &svcsdktypes.AnomalyDetector{SingleMetricAnomalyDetector: sdkSingle},
+			want: false,
+		},
+		{
+			name: "single metric with a missing dimension",
+			ko:   singleMetricDetector(nil, instance),
+			elem: &svcsdktypes.AnomalyDetector{SingleMetricAnomalyDetector: sdkSingle},
+			want: false,
+		},
+		{
+			name: "single metric against a metric math detector",
+			ko:   singleMetricDetector(nil, instance, asg),
+			elem: &svcsdktypes.AnomalyDetector{MetricMathAnomalyDetector: sdkMathDetector("m1/60")},
+			want: false,
+		},
+		{
+			name: "metric math ignoring labels and return data",
+			ko:   mathDetector("m1/60"),
+			elem: &svcsdktypes.AnomalyDetector{MetricMathAnomalyDetector: sdkMathDetector("m1/60")},
+			want: true,
+		},
+		{
+			name: "metric math with a different expression",
+			ko:   mathDetector("m1/60"),
+			elem: &svcsdktypes.AnomalyDetector{MetricMathAnomalyDetector: sdkMathDetector("m1/300")},
+			want: false,
+		},
+		{
+			name: "adopted by detector ID",
+			ko: &svcapitypes.AnomalyDetector{
+				Status: svcapitypes.AnomalyDetectorStatus{AnomalyDetectorID: aws.String("ad-1")},
+			},
+			elem: &svcsdktypes.AnomalyDetector{AnomalyDetectorId: aws.String("ad-1")},
+			want: true,
+		},
+		{
+			name: "spec takes precedence over detector ID",
+			ko: func() *svcapitypes.AnomalyDetector {
+				ko := mathDetector("m1/300")
+				ko.Status.AnomalyDetectorID = aws.String("ad-1")
+				return ko
+			}(),
+			elem: &svcsdktypes.AnomalyDetector{
+				AnomalyDetectorId:         aws.String("ad-1"),
+				MetricMathAnomalyDetector: sdkMathDetector("m1/60"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := anomalyDetectorMatches(tt.ko, tt.elem); got != tt.want {
+				t.Errorf("anomalyDetectorMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetResourceFromAnomalyDetector(t *testing.T) {
+	start := time.Date(2026, 11, 27, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	t.Run("configuration and state", func(t *testing.T) {
+		desired := mathDetector("m1/60")
+		desired.Spec.Configuration = &svcapitypes.AnomalyDetectorConfiguration{
+			ExcludedTimeRanges: []*svcapitypes.Range{
+				{StartTime: &metav1.Time{Time: start}, EndTime: &metav1.Time{Time: end}},
+			},
+			MetricTimezone: aws.String("Europe/Paris"),
+		}
+		ko := desired.DeepCopy()
+		setResourceFromAnomalyDetector(ko, &svcsdktypes.AnomalyDetector{
+			AnomalyDetectorId: aws.String("ad-1"),
+			Configuration: &svcsdktypes.AnomalyDetectorConfiguration{
+				ExcludedTimeRanges: []svcsdktypes.Range{{StartTime: &start, EndTime: &end}},
+				MetricTimezone:     aws.String("Europe/Paris"),
+			},
+			MetricMathAnomalyDetector: sdkMathDetector("m1/60"),
+			StateValue:                svcsdktypes.AnomalyDetectorStateValueTrained,
+		}, desired)
+
+		if got := aws.ToString(ko.Status.AnomalyDetectorID); got != "ad-1" {
+			t.Errorf("AnomalyDetectorID = %q, want %q", got, "ad-1")
+		}
+		if got := aws.ToString(ko.Status.StateValue); got != "TRAINED" {
+			t.Errorf("StateValue = %q, want %q", got, "TRAINED")
+		}
+		delta := newResourceDelta(&resource{desired}, &resource{ko})
+		if len(delta.Differences) != 0 {
+			t.Errorf("unexpected differences: %v", delta.Differences)
+		}
+	})
+
+	t.Run("empty defaults are dropped", func(t *testing.T) {
+		desired := singleMetricDetector(nil)
+		ko := desired.DeepCopy()
+		setResourceFromAnomalyDetector(ko, &svcsdktypes.AnomalyDetector{
+			Configuration:         &svcsdktypes.AnomalyDetectorConfiguration{},
+			MetricCharacteristics: &svcsdktypes.MetricCharacteristics{PeriodicSpikes: aws.Bool(false)},
+			SingleMetricAnomalyDetector: &svcsdktypes.SingleMetricAnomalyDetector{
+				AccountId:  aws.String("111111111111"),
+				MetricName: aws.String("CPUUtilization"),
+				Namespace:  aws.String("AWS/EC2"),
+				Stat:       aws.String("Average"),
+			},
+		}, desired)
+
+		delta := newResourceDelta(&resource{desired}, &resource{ko})
+		if len(delta.Differences) != 0 {
+			t.Errorf("unexpected differences: %v", delta.Differences)
+		}
+	})
+
+	t.Run("excluded time range removed in CloudWatch", func(t *testing.T) {
+		desired := singleMetricDetector(nil)
+		desired.Spec.Configuration = &svcapitypes.AnomalyDetectorConfiguration{
+			ExcludedTimeRanges: []*svcapitypes.Range{
+				{StartTime: &metav1.Time{Time: start}, EndTime: &metav1.Time{Time: end}},
+			},
+		}
+		ko := desired.DeepCopy()
+		setResourceFromAnomalyDetector(ko, &svcsdktypes.AnomalyDetector{
+			Configuration: &svcsdktypes.AnomalyDetectorConfiguration{},
+		}, desired)
+
+		delta := newResourceDelta(&resource{desired}, &resource{ko})
+		if !delta.DifferentAt("Spec.Configuration.ExcludedTimeRanges") {
+			t.Errorf("expected a difference at Spec.Configuration.ExcludedTimeRanges, got %v", delta.Differences)
+		}
+	})
+
+	t.Run("adopted detector", func(t *testing.T) {
+		desired := &svcapitypes.AnomalyDetector{
+			Status: svcapitypes.AnomalyDetectorStatus{AnomalyDetectorID: aws.String("ad-1")},
+		}
+		ko := desired.DeepCopy()
+		setResourceFromAnomalyDetector(ko, &svcsdktypes.AnomalyDetector{
+			AnomalyDetectorId:         aws.String("ad-1"),
+			MetricMathAnomalyDetector: sdkMathDetector("m1/60"),
+		}, desired)
+
+		if ko.Spec.SingleMetricAnomalyDetector != nil {
+			t.Errorf("SingleMetricAnomalyDetector = %v, want nil", ko.Spec.SingleMetricAnomalyDetector)
+		}
+		if ko.Spec.MetricMathAnomalyDetector == nil ||
+			!metricDataQueriesEqual(ko.Spec.MetricMathAnomalyDetector.MetricDataQueries, sdkMathDetector("m1/60").MetricDataQueries) {
+			t.Errorf("MetricMathAnomalyDetector = %v, want the detector read from CloudWatch", ko.Spec.MetricMathAnomalyDetector)
+		}
+	})
+}
+
+func TestValidateAnomalyDetector(t *testing.T) {
+	both := singleMetricDetector(nil)
+	both.Spec.MetricMathAnomalyDetector = mathDetector("m1/60").Spec.MetricMathAnomalyDetector
+
+	tests := []struct {
+		name    string
+		ko      *svcapitypes.AnomalyDetector
+		wantErr bool
+	}{
+		{name: "single metric", ko: singleMetricDetector(nil)},
+		{name: "metric math", ko: mathDetector("m1/60")},
+		{name: "neither", ko: &svcapitypes.AnomalyDetector{}, wantErr: true},
+		{name: "both", ko: both, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAnomalyDetector(&resource{tt.ko})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateAnomalyDetector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
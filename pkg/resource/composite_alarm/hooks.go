@@ -0,0 +1,213 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package composite_alarm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/smithy-go"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
+)
+
+// alarmRulePlaceholderRegex matches the ${name} and ${namespace/name}
+// placeholders that refer to MetricAlarm resources listed in AlarmRefs.
+var alarmRulePlaceholderRegex = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// customFindCompositeAlarm reads the composite alarm with DescribeAlarms. The
+// generated ReadMany code can't be used here because DescribeAlarms is bound
+// to MetricAlarm and only returns the MetricAlarms wrapper.
+func (rm *resourceManager) customFindCompositeAlarm(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindCompositeAlarm")
+	defer func() {
+		exit(err)
+	}()
+
+	// If any required fields in the input shape are missing, AWS resource is
+	// not created yet. Return NotFound here to indicate to callers that the
+	// resource isn't yet created.
+	if r.ko.Spec.Name == nil {
+		return nil, ackerr.NotFound
+	}
+
+	input := &svcsdk.DescribeAlarmsInput{
+		AlarmNames: []string{*r.ko.Spec.Name},
+		AlarmTypes: []svcsdktypes.AlarmType{svcsdktypes.AlarmTypeCompositeAlarm},
+	}
+
+	var resp *svcsdk.DescribeAlarmsOutput
+	resp, err = rm.sdkapi.DescribeAlarms(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeAlarms", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "UNKNOWN" {
+			return nil, ackerr.NotFound
+		}
+		return nil, err
+	}
+
+	// Merge in the information we read from the API call above to the copy of
+	// the original Kubernetes object we passed to the function
+	ko := r.ko.DeepCopy()
+
+	found := false
+	for _, elem := range resp.CompositeAlarms {
+		if elem.AlarmName == nil || *elem.AlarmName != *r.ko.Spec.Name {
+			continue
+		}
+		if ko.Status.ACKResourceMetadata == nil {
+			ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+		}
+		if elem.AlarmArn != nil {
+			arn := ackv1alpha1.AWSResourceName(*elem.AlarmArn)
+			ko.Status.ACKResourceMetadata.ARN = &arn
+		}
+		ko.Spec.ActionsEnabled = elem.ActionsEnabled
+		ko.Spec.ActionsSuppressor = elem.ActionsSuppressor
+		if elem.ActionsSuppressorExtensionPeriod != nil {
+			actionsSuppressorExtensionPeriodCopy := int64(*elem.ActionsSuppressorExtensionPeriod)
+			ko.Spec.ActionsSuppressorExtensionPeriod = &actionsSuppressorExtensionPeriodCopy
+		} else {
+			ko.Spec.ActionsSuppressorExtensionPeriod = nil
+		}
+		if elem.ActionsSuppressorWaitPeriod != nil {
+			actionsSuppressorWaitPeriodCopy := int64(*elem.ActionsSuppressorWaitPeriod)
+			ko.Spec.ActionsSuppressorWaitPeriod = &actionsSuppressorWaitPeriodCopy
+		} else {
+			ko.Spec.ActionsSuppressorWaitPeriod = nil
+		}
+		if elem.AlarmActions != nil {
+			ko.Spec.AlarmActions = aws.StringSlice(elem.AlarmActions)
+		} else {
+			ko.Spec.AlarmActions = nil
+		}
+		ko.Spec.AlarmDescription = elem.AlarmDescription
+		ko.Spec.AlarmRule = elem.AlarmRule
+		if elem.InsufficientDataActions != nil {
+			ko.Spec.InsufficientDataActions = aws.StringSlice(elem.InsufficientDataActions)
+		} else {
+			ko.Spec.InsufficientDataActions = nil
+		}
+		if elem.OKActions != nil {
+			ko.Spec.OKActions = aws.StringSlice(elem.OKActions)
+		} else {
+			ko.Spec.OKActions = nil
+		}
+		found = true
+		break
+	}
+	if !found {
+		return nil, ackerr.NotFound
+	}
+	// DescribeAlarms doesn't return tags, so they are read with
+	// ListTagsForResource.
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+
+	// The rule stored in CloudWatch has the placeholders already replaced.
+	// Keep the templated rule from the spec when it renders to the same
+	// expression, so that it doesn't show up as a difference.
+	if r.ko.Spec.AlarmRule != nil && ko.Spec.AlarmRule != nil {
+		if rendered, err := renderAlarmRule(r.ko); err == nil && *rendered == *ko.Spec.AlarmRule {
+			ko.Spec.AlarmRule = r.ko.Spec.AlarmRule
+		}
+	}
+
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// setAlarmRule sets the AlarmRule of the PutCompositeAlarm input to the
+// rendered rule expression of the supplied resource.
+func (rm *resourceManager) setAlarmRule(
+	input *svcsdk.PutCompositeAlarmInput,
+	r *resource,
+) error {
+	if r.ko.Spec.AlarmRule == nil {
+		return nil
+	}
+	rule, err := renderAlarmRule(r.ko)
+	if err != nil {
+		return err
+	}
+	input.AlarmRule = rule
+	return nil
+}
+
+// renderAlarmRule returns the AlarmRule expression with every ${name} and
+// ${namespace/name} placeholder replaced by the name of the matching
+// MetricAlarm resolved from AlarmRefs. The expression is returned unchanged
+// when the resource has no AlarmRefs.
+func renderAlarmRule(ko *svcapitypes.CompositeAlarm) (*string, error) {
+	if len(ko.Spec.AlarmRefs) == 0 {
+		return ko.Spec.AlarmRule, nil
+	}
+
+	// AlarmNames is filled in the same order as AlarmRefs by
+	// resolveReferenceForAlarmNames.
+	alarmNames := map[string]string{}
+	i := 0
+	for _, ref := range ko.Spec.AlarmRefs {
+		if ref == nil || ref.From == nil || ref.From.Name == nil {
+			continue
+		}
+		if i >= len(ko.Spec.AlarmNames) || ko.Spec.AlarmNames[i] == nil {
+			return nil, fmt.Errorf("alarm reference %q has not been resolved", *ref.From.Name)
+		}
+		namespace := ko.Namespace
+		if ref.From.Namespace != nil && *ref.From.Namespace != "" {
+			namespace = *ref.From.Namespace
+		}
+		alarmNames[namespace+"/"+*ref.From.Name] = *ko.Spec.AlarmNames[i]
+		if namespace == ko.Namespace {
+			alarmNames[*ref.From.Name] = *ko.Spec.AlarmNames[i]
+		}
+		i++
+	}
+
+	var missing []string
+	rule := alarmRulePlaceholderRegex.ReplaceAllStringFunc(*ko.Spec.AlarmRule, func(placeholder string) string {
+		key := alarmRulePlaceholderRegex.FindStringSubmatch(placeholder)[1]
+		name, ok := alarmNames[key]
+		if !ok {
+			missing = append(missing, key)
+			return placeholder
+		}
+		return name
+	})
+	if len(missing) > 0 {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"alarmRule references %q which is not listed in alarmRefs", missing[0],
+		))
+	}
+	return &rule, nil
+}
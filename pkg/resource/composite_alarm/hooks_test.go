@@ -0,0 +1,88 @@
+package composite_alarm
+
+import (
+	"testing"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func alarmRef(namespace, name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	ref := &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+	if namespace != "" {
+		ref.From.Namespace = aws.String(namespace)
+	}
+	return ref
+}
+
+func TestRenderAlarmRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       string
+		refs       []*ackv1alpha1.AWSResourceReferenceWrapper
+		alarmNames []*string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name: "no references",
+			rule: `ALARM("${cpu}")`,
+			want: `ALARM("${cpu}")`,
+		},
+		{
+			name:       "placeholders by name",
+			rule:       `ALARM(${cpu}) AND NOT ALARM(${deploy})`,
+			refs:       []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu"), alarmRef("", "deploy")},
+			alarmNames: []*string{aws.String("prod-cpu-high"), aws.String("deployment-in-progress")},
+			want:       `ALARM(prod-cpu-high) AND NOT ALARM(deployment-in-progress)`,
+		},
+		{
+			name:       "placeholder by namespace and name",
+			rule:       `ALARM("${other/cpu}") OR ALARM("${cpu}")`,
+			refs:       []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("other", "cpu"), alarmRef("default", "cpu")},
+			alarmNames: []*string{aws.String("other-cpu"), aws.String("default-cpu")},
+			want:       `ALARM("other-cpu") OR ALARM("default-cpu")`,
+		},
+		{
+			name:       "placeholder not in alarmRefs",
+			rule:       `ALARM(${disk})`,
+			refs:       []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu")},
+			alarmNames: []*string{aws.String("prod-cpu-high")},
+			wantErr:    true,
+		},
+		{
+			name:    "references not resolved",
+			rule:    `ALARM(${cpu})`,
+			refs:    []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &svcapitypes.CompositeAlarm{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Spec: svcapitypes.CompositeAlarmSpec{
+					AlarmRule:  aws.String(tt.rule),
+					AlarmRefs:  tt.refs,
+					AlarmNames: tt.alarmNames,
+				},
+			}
+
+			got, err := renderAlarmRule(ko)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renderAlarmRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if *got != tt.want {
+				t.Errorf("renderAlarmRule() = %q, want %q", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+const (
+	// configMapRefKind describes DashboardBodyFrom in the cross-namespace
+	// reference warnings.
+	configMapRefKind ackrt.CrossNamespaceRefKind = "configmap reference"
+	// dashboardBodyConfigMapIndex indexes the Dashboards by the namespace/name
+	// of the ConfigMap selected by DashboardBodyFrom.
+	dashboardBodyConfigMapIndex = "spec.dashboardBodyFrom.configMapKeyRef"
+)
+
+// configMapReader reads the ConfigMaps selected by DashboardBodyFrom. It is
+// set by SetupConfigMapWatcher.
+var configMapReader client.Reader
+
+// withDashboardBodyFrom returns a copy of the supplied Dashboard with the
+// DashboardBody set to the ConfigMap key selected by DashboardBodyFrom. The
+// Dashboard is returned unchanged when DashboardBodyFrom is not set.
+func (rm *resourceManager) withDashboardBodyFrom(
+	ctx context.Context,
+	ko *svcapitypes.Dashboard,
+) (*svcapitypes.Dashboard, error) {
+	if ko.Spec.DashboardBodyFrom == nil || ko.Spec.DashboardBodyFrom.ConfigMapKeyRef == nil {
+		return ko, nil
+	}
+	if ko.Spec.DashboardBody != nil {
+		return nil, ackerr.NewTerminalError(fmt.Errorf("dashboardBody cannot be set with dashboardBodyFrom"))
+	}
+	ref := ko.Spec.DashboardBodyFrom.ConfigMapKeyRef
+	if ref.Name == "" || ref.Key == "" {
+		return nil, ackerr.NewTerminalError(fmt.Errorf("provided configmap reference is missing a name or a key: DashboardBodyFrom"))
+	}
+	namespace, err := ackrt.ResolveCrossNamespaceReferenceString(
+		ctx,
+		rm.cfg.EnableCrossNamespace,
+		&ko.Status.Conditions,
+		configMapRefKind,
+		ko.ObjectMeta.GetNamespace(),
+		ref.Namespace,
+		ref.Name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if configMapReader == nil {
+		return nil, fmt.Errorf("the dashboard configmap watcher is not set up")
+	}
+	cm := &corev1.ConfigMap{}
+	if err := configMapReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, cm); err != nil {
+		return nil, err
+	}
+	body, ok := cm.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in configmap %s/%s", ref.Key, namespace, ref.Name)
+	}
+	ko = ko.DeepCopy()
+	ko.Spec.DashboardBody = &body
+	return ko, nil
+}
+
+// omitDashboardBodyFrom clears the DashboardBody read from CloudWatch when
+// the Dashboard reads its body from a ConfigMap and that body renders to the
+// same JSON document, as compared by newResourceDelta, since the
+// DashboardBody of the desired resource isn't set. The body is kept when it
+// can't be rendered, so that sdkUpdate reports why.
+func (rm *resourceManager) omitDashboardBodyFrom(
+	ctx context.Context,
+	ko *svcapitypes.Dashboard,
+) {
+	if ko.Spec.DashboardBodyFrom == nil || ko.Spec.DashboardBody == nil {
+		return
+	}
+	desired := ko.DeepCopy()
+	desired.Spec.DashboardBody = nil
+	desired, err := rm.withDashboardBodyFrom(ctx, desired)
+	if err != nil {
+		return
+	}
+	body, err := renderBody(desired)
+	if err != nil {
+		return
+	}
+	if equal, err := ackcompare.DocumentEqual(*body, *ko.Spec.DashboardBody); err != nil || !equal {
+		return
+	}
+	ko.Spec.DashboardBody = nil
+}
+
+// SetupConfigMapWatcher adds to the supplied Dashboard controller a watch
+// of the ConfigMap metadata, which enqueues each Dashboard whose
+// DashboardBodyFrom selects a changed ConfigMap. The Dashboard controller
+// only watches Dashboards and would otherwise not notice the change before
+// the next resync. Only the metadata of the ConfigMaps is cached, the
+// selected key is read when the Dashboard is reconciled. It does nothing
+// when Dashboards are not reconciled.
+func SetupConfigMapWatcher(mgr ctrlrt.Manager, c controller.Controller) error {
+	if c == nil {
+		return nil
+	}
+	configMapReader = mgr.GetAPIReader()
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&svcapitypes.Dashboard{},
+		dashboardBodyConfigMapIndex,
+		indexDashboardBodyConfigMap,
+	); err != nil {
+		return err
+	}
+	cm := &metav1.PartialObjectMetadata{}
+	cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	return c.Watch(source.Kind(
+		mgr.GetCache(),
+		cm,
+		handler.TypedEnqueueRequestsFromMapFunc(dashboardsForConfigMap(mgr.GetClient())),
+		predicate.TypedResourceVersionChangedPredicate[*metav1.PartialObjectMetadata]{},
+	))
+}
+
+// dashboardsForConfigMap returns a map function returning the requests of
+// the Dashboards whose DashboardBodyFrom selects the supplied ConfigMap.
+func dashboardsForConfigMap(
+	kc client.Reader,
+) handler.TypedMapFunc[*metav1.PartialObjectMetadata, reconcile.Request] {
+	return func(ctx context.Context, cm *metav1.PartialObjectMetadata) []reconcile.Request {
+		list := &svcapitypes.DashboardList{}
+		key := types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}.String()
+		if err := kc.List(ctx, list, client.MatchingFields{dashboardBodyConfigMapIndex: key}); err != nil {
+			ctrlrt.LoggerFrom(ctx).Error(err, "unable to list the dashboards reading their body from the configmap", "configmap", key)
+			return nil
+		}
+		requests := make([]reconcile.Request, 0, len(list.Items))
+		for _, d := range list.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: d.Namespace, Name: d.Name},
+			})
+		}
+		return requests
+	}
+}
+
+// indexDashboardBodyConfigMap returns the namespace/name of the ConfigMap
+// selected by the DashboardBodyFrom of the supplied Dashboard.
+func indexDashboardBodyConfigMap(obj client.Object) []string {
+	ko, ok := obj.(*svcapitypes.Dashboard)
+	if !ok || ko.Spec.DashboardBodyFrom == nil || ko.Spec.DashboardBodyFrom.ConfigMapKeyRef == nil {
+		return nil
+	}
+	ref := ko.Spec.DashboardBodyFrom.ConfigMapKeyRef
+	namespace := ko.Namespace
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	return []string{types.NamespacedName{Namespace: namespace, Name: ref.Name}.String()}
+}
@@ -0,0 +1,197 @@
+package dashboard
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func TestWithDashboardBodyFrom(t *testing.T) {
+	const body = `{"widgets":[{"type":"text","properties":{"markdown":"${cluster}"}}]}`
+
+	tests := []struct {
+		name                 string
+		bodyFrom             *svcapitypes.DashboardBodySource
+		enableCrossNamespace bool
+		wantBody             string
+		wantErr              bool
+	}{
+		{
+			name: "no dashboardBodyFrom",
+		},
+		{
+			name: "same namespace",
+			bodyFrom: &svcapitypes.DashboardBodySource{
+				ConfigMapKeyRef: &svcapitypes.ConfigMapKeyReference{Name: "layouts", Key: "service.json"},
+			},
+			wantBody: body,
+		},
+		{
+			name: "other namespace",
+			bodyFrom: &svcapitypes.DashboardBodySource{
+				ConfigMapKeyRef: &svcapitypes.ConfigMapKeyReference{Name: "layouts", Namespace: "shared", Key: "service.json"},
+			},
+			enableCrossNamespace: true,
+			wantBody:             body,
+		},
+		{
+			name: "other namespace not allowed",
+			bodyFrom: &svcapitypes.DashboardBodySource{
+				ConfigMapKeyRef: &svcapitypes.ConfigMapKeyReference{Name: "layouts", Namespace: "shared", Key: "service.json"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing key",
+			bodyFrom: &svcapitypes.DashboardBodySource{
+				ConfigMapKeyRef: &svcapitypes.ConfigMapKeyReference{Name: "layouts", Key: "other.json"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing configmap",
+			bodyFrom: &svcapitypes.DashboardBodySource{
+				ConfigMapKeyRef: &svcapitypes.ConfigMapKeyReference{Name: "missing", Key: "service.json"},
+			},
+			wantErr: true,
+		},
+	}
+
+	configMapReader = fake.NewClientBuilder().WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "layouts"},
+			Data:       map[string]string{"service.json": body},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "layouts"},
+			Data:       map[string]string{"service.json": body},
+		},
+	).Build()
+	defer func() { configMapReader = nil }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := &resourceManager{cfg: ackcfg.Config{EnableCrossNamespace: tt.enableCrossNamespace}}
+			ko := &svcapitypes.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "service"},
+				Spec:       svcapitypes.DashboardSpec{DashboardBodyFrom: tt.bodyFrom},
+			}
+
+			got, err := rm.withDashboardBodyFrom(context.TODO(), ko)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("withDashboardBodyFrom() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if ko.Spec.DashboardBody != nil {
+				t.Errorf("withDashboardBodyFrom() modified the supplied Dashboard")
+			}
+			if tt.wantBody == "" {
+				if got.Spec.DashboardBody != nil {
+					t.Errorf("withDashboardBodyFrom() DashboardBody = %s", *got.Spec.DashboardBody)
+				}
+				return
+			}
+			if got.Spec.DashboardBody == nil || *got.Spec.DashboardBody != tt.wantBody {
+				t.Errorf("withDashboardBodyFrom() DashboardBody = %v, want %s", got.Spec.DashboardBody, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestDashboardsForConfigMap(t *testing.T) {
+	dashboard := func(namespace, name string, ref *svcapitypes.ConfigMapKeyReference) *svcapitypes.Dashboard {
+		d := &svcapitypes.Dashboard{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		if ref != nil {
+			d.Spec.DashboardBodyFrom = &svcapitypes.DashboardBodySource{ConfigMapKeyRef: ref}
+		} else {
+			d.Spec.DashboardBody = aws.String(`{"widgets":[]}`)
+		}
+		return d
+	}
+
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	kc := fake.NewClientBuilder().WithScheme(scheme).WithIndex(
+		&svcapitypes.Dashboard{}, dashboardBodyConfigMapIndex, indexDashboardBodyConfigMap,
+	).WithObjects(
+		dashboard("team-a", "service", &svcapitypes.ConfigMapKeyReference{Name: "layouts", Key: "service.json"}),
+		dashboard("team-a", "other-key", &svcapitypes.ConfigMapKeyReference{Name: "layouts", Key: "other.json"}),
+		dashboard("team-b", "shared", &svcapitypes.ConfigMapKeyReference{Name: "layouts", Namespace: "team-a", Key: "service.json"}),
+		dashboard("team-b", "same-name", &svcapitypes.ConfigMapKeyReference{Name: "layouts", Key: "service.json"}),
+		dashboard("team-a", "inline", nil),
+	).Build()
+
+	cm := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "layouts"}}
+	got := map[string]bool{}
+	for _, req := range dashboardsForConfigMap(kc)(context.TODO(), cm) {
+		got[req.String()] = true
+	}
+	want := map[string]bool{"team-a/service": true, "team-a/other-key": true, "team-b/shared": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dashboardsForConfigMap() = %v, want %v", got, want)
+	}
+}
+
+func TestOmitDashboardBodyFrom(t *testing.T) {
+	tests := []struct {
+		name     string
+		readBody string
+		wantBody bool
+	}{
+		{
+			name:     "same document",
+			readBody: `{"widgets":[{"type":"text","properties":{"markdown":"prod"}}]}`,
+		},
+		{
+			name:     "same document formatted differently",
+			readBody: `{ "widgets": [ { "properties": { "markdown": "prod" }, "type": "text" } ] }`,
+		},
+		{
+			name:     "other document",
+			readBody: `{"widgets":[{"type":"text","properties":{"markdown":"staging"}}]}`,
+			wantBody: true,
+		},
+	}
+
+	configMapReader = fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "layouts"},
+		Data: map[string]string{
+			"service.json": `{"widgets":[{"type":"text","properties":{"markdown":"${cluster}"}}]}`,
+		},
+	}).Build()
+	defer func() { configMapReader = nil }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := &resourceManager{}
+			ko := &svcapitypes.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "service"},
+				Spec: svcapitypes.DashboardSpec{
+					DashboardBody: aws.String(tt.readBody),
+					DashboardBodyFrom: &svcapitypes.DashboardBodySource{
+						ConfigMapKeyRef: &svcapitypes.ConfigMapKeyReference{Name: "layouts", Key: "service.json"},
+					},
+					Variables: map[string]*string{"cluster": aws.String("prod")},
+				},
+			}
+
+			rm.omitDashboardBodyFrom(context.TODO(), ko)
+			if got := ko.Spec.DashboardBody != nil; got != tt.wantBody {
+				t.Errorf("omitDashboardBodyFrom() kept DashboardBody = %v, want %v", got, tt.wantBody)
+			}
+		})
+	}
+}
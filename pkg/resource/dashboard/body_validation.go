@@ -0,0 +1,300 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+const (
+	// dashboardGridColumns is the number of columns of the dashboard grid.
+	dashboardGridColumns = 24
+	// maxWidgetHeight is the largest height, in grid units, of a widget.
+	maxWidgetHeight = 1000
+	// maxDashboardWidgets is the number of widgets a dashboard can have.
+	maxDashboardWidgets = 500
+)
+
+// widgetTypeCustom is the type of the Lambda-backed custom widgets, which
+// can't be described with Widgets but may be used in DashboardBody.
+const widgetTypeCustom = "custom"
+
+// widgetRequiredProperties is the property each widget type must set in its
+// properties.
+var widgetRequiredProperties = map[string]string{
+	widgetTypeAlarm:    "alarms",
+	widgetTypeCustom:   "endpoint",
+	widgetTypeExplorer: "metrics",
+	widgetTypeLog:      "query",
+	widgetTypeMetric:   "metrics",
+	widgetTypeText:     "markdown",
+}
+
+// validateDashboardBody checks the structure of a dashboard body the way
+// PutDashboard does, without calling CloudWatch: the widgets array, the
+// widget types, the position of each widget on the 24 column grid, the
+// properties each widget type requires and the shape of the metric arrays.
+// The problems found are returned as DashboardValidationMessages, with the
+// DataPath of each one being a JSON pointer into the body. Widget types
+// this validator doesn't know, which CloudWatch may have added since, are
+// returned as warnings and their properties are left for CloudWatch to
+// validate.
+func validateDashboardBody(body string) (messages, warnings []*svcapitypes.DashboardValidationMessage) {
+	v := &bodyValidator{}
+	var doc any
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		v.add("", "The dashboard body is not valid JSON: %v", err)
+		return v.messages, v.warnings
+	}
+	root, ok := doc.(map[string]any)
+	if !ok {
+		v.add("", "Should be object")
+		return v.messages, v.warnings
+	}
+	widgets, ok := v.required("", root, "widgets")
+	if !ok {
+		return v.messages, v.warnings
+	}
+	list, ok := widgets.([]any)
+	if !ok {
+		v.add("/widgets", "Should be array")
+		return v.messages, v.warnings
+	}
+	if len(list) > maxDashboardWidgets {
+		v.add("/widgets", "Should NOT have more than %d items", maxDashboardWidgets)
+	}
+	for i, widget := range list {
+		v.validateWidget(fmt.Sprintf("/widgets/%d", i), widget)
+	}
+	return v.messages, v.warnings
+}
+
+// bodyValidator collects the problems and the warnings found in a dashboard
+// body.
+type bodyValidator struct {
+	messages []*svcapitypes.DashboardValidationMessage
+	warnings []*svcapitypes.DashboardValidationMessage
+}
+
+func (v *bodyValidator) add(path string, format string, args ...any) {
+	v.messages = append(v.messages, newValidationMessage(path, format, args...))
+}
+
+func (v *bodyValidator) warn(path string, format string, args ...any) {
+	v.warnings = append(v.warnings, newValidationMessage(path, format, args...))
+}
+
+func newValidationMessage(path string, format string, args ...any) *svcapitypes.DashboardValidationMessage {
+	return &svcapitypes.DashboardValidationMessage{
+		DataPath: aws.String(path),
+		Message:  aws.String(fmt.Sprintf(format, args...)),
+	}
+}
+
+// required returns the value of the named property of the object at path,
+// and records a problem if it is missing.
+func (v *bodyValidator) required(path string, object map[string]any, name string) (any, bool) {
+	value, ok := object[name]
+	if !ok {
+		v.add(path, "Should have required property '%s'", name)
+	}
+	return value, ok
+}
+
+// integer returns the value of the named property of the widget at path.
+// It returns false when the property is not set or isn't an integer, in
+// which case a problem is recorded.
+func (v *bodyValidator) integer(path string, widget map[string]any, name string) (int64, bool) {
+	value, ok := widget[name]
+	if !ok {
+		return 0, false
+	}
+	number, ok := value.(json.Number)
+	if !ok {
+		v.add(path+"/"+name, "Should be integer")
+		return 0, false
+	}
+	i, err := number.Int64()
+	if err != nil {
+		v.add(path+"/"+name, "Should be integer")
+		return 0, false
+	}
+	return i, true
+}
+
+func (v *bodyValidator) validateWidget(path string, value any) {
+	widget, ok := value.(map[string]any)
+	if !ok {
+		v.add(path, "Should be object")
+		return
+	}
+	v.validateGridPosition(path, widget)
+
+	widgetType := ""
+	if value, ok := v.required(path, widget, "type"); ok {
+		var isString bool
+		if widgetType, isString = value.(string); !isString {
+			v.add(path+"/type", "Should be string")
+		} else if _, known := widgetRequiredProperties[widgetType]; !known {
+			v.warn(path+"/type", "Unknown widget type %q, its properties are not validated", widgetType)
+			widgetType = ""
+		}
+	}
+	value, ok = v.required(path, widget, "properties")
+	if !ok {
+		return
+	}
+	path += "/properties"
+	properties, ok := value.(map[string]any)
+	if !ok {
+		v.add(path, "Should be object")
+		return
+	}
+	if widgetType == "" {
+		return
+	}
+
+	name := widgetRequiredProperties[widgetType]
+	// A metric widget may graph a single alarm from its annotations instead
+	// of listing metrics.
+	if widgetType == widgetTypeMetric && properties[name] == nil && hasAlarmAnnotation(properties) {
+		return
+	}
+	value, ok = v.required(path, properties, name)
+	if !ok {
+		return
+	}
+	path += "/" + name
+	switch widgetType {
+	case widgetTypeAlarm:
+		alarms, ok := value.([]any)
+		if !ok {
+			v.add(path, "Should be array")
+			return
+		}
+		for i, alarm := range alarms {
+			if _, ok := alarm.(string); !ok {
+				v.add(fmt.Sprintf("%s/%d", path, i), "Should be string")
+			}
+		}
+	case widgetTypeExplorer:
+		if _, ok := value.([]any); !ok {
+			v.add(path, "Should be array")
+		}
+	case widgetTypeMetric:
+		v.validateMetrics(path, value)
+	default:
+		if _, ok := value.(string); !ok {
+			v.add(path, "Should be string")
+		}
+	}
+}
+
+// validateGridPosition checks that the widget at path has a valid position
+// and size, and fits within the columns of the grid. Every field is
+// optional, CloudWatch places and sizes the widget when they aren't set.
+func (v *bodyValidator) validateGridPosition(path string, widget map[string]any) {
+	x, hasX := v.integer(path, widget, "x")
+	if hasX && (x < 0 || x >= dashboardGridColumns) {
+		v.add(path+"/x", "Should be >= 0 and < %d", dashboardGridColumns)
+		hasX = false
+	}
+	if y, ok := v.integer(path, widget, "y"); ok && y < 0 {
+		v.add(path+"/y", "Should be >= 0")
+	}
+	width, hasWidth := v.integer(path, widget, "width")
+	if hasWidth && (width < 1 || width > dashboardGridColumns) {
+		v.add(path+"/width", "Should be >= 1 and <= %d", dashboardGridColumns)
+		hasWidth = false
+	}
+	if height, ok := v.integer(path, widget, "height"); ok && (height < 1 || height > maxWidgetHeight) {
+		v.add(path+"/height", "Should be >= 1 and <= %d", maxWidgetHeight)
+	}
+	if hasX && hasWidth && x+width > dashboardGridColumns {
+		v.add(path, "Widget should fit within the %d columns of the grid, x + width is %d",
+			dashboardGridColumns, x+width)
+	}
+}
+
+// validateMetrics checks the metrics array of a metric widget. Each entry is
+// an array holding the namespace, the metric name and the dimension
+// name/value pairs, optionally followed by an object with the rendering
+// options. An entry may start with ".." or "..." to repeat the leading
+// values of the previous entry, and a metric math expression is an entry
+// holding only an object with an expression.
+func (v *bodyValidator) validateMetrics(path string, value any) {
+	metrics, ok := value.([]any)
+	if !ok {
+		v.add(path, "Should be array")
+		return
+	}
+	for i, value := range metrics {
+		metricPath := fmt.Sprintf("%s/%d", path, i)
+		metric, ok := value.([]any)
+		if !ok {
+			v.add(metricPath, "Should be array")
+			continue
+		}
+		if len(metric) == 0 {
+			v.add(metricPath, "Should NOT have fewer than 1 items")
+			continue
+		}
+
+		names := metric
+		if options, ok := metric[len(metric)-1].(map[string]any); ok {
+			names = metric[:len(metric)-1]
+			if len(names) == 0 {
+				if _, ok := options["expression"]; !ok {
+					v.add(fmt.Sprintf("%s/0", metricPath), "Should have required property 'expression'")
+				}
+				continue
+			}
+		}
+		valid := true
+		for j, name := range names {
+			if _, ok := name.(string); !ok {
+				v.add(fmt.Sprintf("%s/%d", metricPath, j), "Should be string")
+				valid = false
+			}
+		}
+		if !valid || names[0] == ".." || names[0] == "..." {
+			continue
+		}
+		switch {
+		case len(names) < 2:
+			v.add(metricPath, "Should have a namespace and a metric name")
+		case len(names)%2 != 0:
+			v.add(metricPath, "Should have a value for every dimension name")
+		}
+	}
+}
+
+// hasAlarmAnnotation returns true if the properties of a metric widget
+// annotate the graph with an alarm.
+func hasAlarmAnnotation(properties map[string]any) bool {
+	annotations, ok := properties["annotations"].(map[string]any)
+	if !ok {
+		return false
+	}
+	alarms, ok := annotations["alarms"].([]any)
+	return ok && len(alarms) > 0
+}
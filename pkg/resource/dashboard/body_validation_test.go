@@ -0,0 +1,284 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func TestValidateDashboardBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		// want and wantWarnings list the expected messages and warnings as
+		// "DataPath: Message".
+		want         []string
+		wantWarnings []string
+	}{
+		{
+			name: "valid widgets",
+			body: `{"widgets":[
+				{"type":"metric","x":0,"y":0,"width":12,"height":6,"properties":{"metrics":[
+					["AWS/EC2","CPUUtilization","InstanceId","i-1234567890abcdef0",{"id":"m1"}],
+					["..","i-0fedcba0987654321"],
+					["AWS/Lambda","Errors","FunctionName","bar"],
+					["...","FunctionName","foo"],
+					[{"expression":"m1 * 2","label":"double"}]
+				],"region":"us-west-2"}},
+				{"type":"metric","x":12,"width":12,"properties":{"annotations":{"alarms":["arn:aws:cloudwatch:us-west-2:111122223333:alarm:cpu"]}}},
+				{"type":"text","properties":{"markdown":"# Hello"}},
+				{"type":"alarm","properties":{"alarms":["arn:aws:cloudwatch:us-west-2:111122223333:alarm:cpu"]}},
+				{"type":"log","properties":{"query":"SOURCE 'app' | fields @message"}},
+				{"type":"explorer","properties":{"metrics":[{"metricName":"CPUUtilization"}]}},
+				{"type":"custom","properties":{"endpoint":"arn:aws:lambda:us-west-2:111122223333:function:widget"}}
+			]}`,
+		},
+		{
+			name: "not json",
+			body: `{"widgets":[`,
+			want: []string{": The dashboard body is not valid JSON: unexpected EOF"},
+		},
+		{
+			name: "no widgets",
+			body: `{"start":"-PT6H"}`,
+			want: []string{": Should have required property 'widgets'"},
+		},
+		{
+			name: "widgets not an array",
+			body: `{"widgets":{}}`,
+			want: []string{"/widgets: Should be array"},
+		},
+		{
+			name:         "unknown widget type",
+			body:         `{"widgets":[{"type":"graph","properties":{}}]}`,
+			wantWarnings: []string{`/widgets/0/type: Unknown widget type "graph", its properties are not validated`},
+		},
+		{
+			name: "widget type not a string",
+			body: `{"widgets":[{"type":1,"properties":{}}]}`,
+			want: []string{"/widgets/0/type: Should be string"},
+		},
+		{
+			name: "widget without type and properties",
+			body: `{"widgets":[{"x":0}]}`,
+			want: []string{
+				"/widgets/0: Should have required property 'type'",
+				"/widgets/0: Should have required property 'properties'",
+			},
+		},
+		{
+			name: "missing required properties",
+			body: `{"widgets":[
+				{"type":"text","properties":{}},
+				{"type":"log","properties":{"query":["fields @message"]}},
+				{"type":"alarm","properties":{"alarms":[1]}}
+			]}`,
+			want: []string{
+				"/widgets/0/properties: Should have required property 'markdown'",
+				"/widgets/1/properties/query: Should be string",
+				"/widgets/2/properties/alarms/0: Should be string",
+			},
+		},
+		{
+			name: "widget outside of the grid",
+			body: `{"widgets":[
+				{"type":"text","x":18,"width":12,"properties":{"markdown":"a"}},
+				{"type":"text","x":24,"y":-1,"width":0,"height":1.5,"properties":{"markdown":"b"}}
+			]}`,
+			want: []string{
+				"/widgets/0: Widget should fit within the 24 columns of the grid, x + width is 30",
+				"/widgets/1/x: Should be >= 0 and < 24",
+				"/widgets/1/y: Should be >= 0",
+				"/widgets/1/width: Should be >= 1 and <= 24",
+				"/widgets/1/height: Should be integer",
+			},
+		},
+		{
+			name: "malformed metric arrays",
+			body: `{"widgets":[{"type":"metric","properties":{"metrics":[
+				"AWS/EC2",
+				[],
+				["AWS/EC2"],
+				["AWS/EC2","CPUUtilization","InstanceId"],
+				["AWS/EC2",{"stat":"Average"},"CPUUtilization"],
+				[{"label":"no expression"}]
+			]}}]}`,
+			want: []string{
+				"/widgets/0/properties/metrics/0: Should be array",
+				"/widgets/0/properties/metrics/1: Should NOT have fewer than 1 items",
+				"/widgets/0/properties/metrics/2: Should have a namespace and a metric name",
+				"/widgets/0/properties/metrics/3: Should have a value for every dimension name",
+				"/widgets/0/properties/metrics/4/1: Should be string",
+				"/widgets/0/properties/metrics/5/0: Should have required property 'expression'",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages, warnings := validateDashboardBody(tt.body)
+			got, gotWarnings := []string{}, []string{}
+			for _, m := range messages {
+				got = append(got, *m.DataPath+": "+*m.Message)
+			}
+			for _, m := range warnings {
+				gotWarnings = append(gotWarnings, *m.DataPath+": "+*m.Message)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("validateDashboardBody() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+			if strings.Join(gotWarnings, "\n") != strings.Join(tt.wantWarnings, "\n") {
+				t.Errorf("validateDashboardBody() warnings =\n%s\nwant\n%s", strings.Join(gotWarnings, "\n"), strings.Join(tt.wantWarnings, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidateDashboardBodyWidgetLimit(t *testing.T) {
+	widgets := make([]string, maxDashboardWidgets+1)
+	for i := range widgets {
+		widgets[i] = `{"type":"text","properties":{"markdown":"a"}}`
+	}
+	messages, _ := validateDashboardBody(fmt.Sprintf(`{"widgets":[%s]}`, strings.Join(widgets, ",")))
+	if len(messages) != 1 || *messages[0].DataPath != "/widgets" {
+		t.Errorf("validateDashboardBody() = %v, want a single message on /widgets", messages)
+	}
+}
+
+func TestValidateDashboardSpec(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         svcapitypes.DashboardSpec
+		wants        []string
+		wantWarnings int
+	}{
+		{
+			name: "body with variables",
+			spec: svcapitypes.DashboardSpec{
+				DashboardBody: aws.String(`{"widgets":[{"type":"text","width":${width},"properties":{"markdown":"${alarm}"}}]}`),
+				Variables:     map[string]*string{"width": aws.String("12")},
+			},
+		},
+		{
+			name: "invalid body",
+			spec: svcapitypes.DashboardSpec{
+				DashboardBody: aws.String(`{"widgets":[{"type":"text","properties":{}}]}`),
+			},
+			wants: []string{"spec.dashboardBody"},
+		},
+		{
+			name: "body with an unknown widget type",
+			spec: svcapitypes.DashboardSpec{
+				DashboardBody: aws.String(`{"widgets":[{"type":"graph","properties":{}}]}`),
+			},
+			wantWarnings: 1,
+		},
+		{
+			name: "widgets",
+			spec: svcapitypes.DashboardSpec{
+				Widgets: []*svcapitypes.DashboardWidget{{
+					Text: &svcapitypes.DashboardTextWidget{Markdown: aws.String("# Hello")},
+				}},
+			},
+		},
+		{
+			name: "widgets outside of the grid",
+			spec: svcapitypes.DashboardSpec{
+				Widgets: []*svcapitypes.DashboardWidget{{
+					X: aws.Int64(20), Width: aws.Int64(6),
+					Text: &svcapitypes.DashboardTextWidget{Markdown: aws.String("# Hello")},
+				}},
+			},
+			wants: []string{"spec.widgets"},
+		},
+		{
+			name: "widgets with body",
+			spec: svcapitypes.DashboardSpec{
+				DashboardBody: aws.String(`{"widgets":[]}`),
+				Widgets:       []*svcapitypes.DashboardWidget{},
+			},
+			wants: []string{"spec.widgets"},
+		},
+		{
+			name: "body from configmap",
+			spec: svcapitypes.DashboardSpec{
+				DashboardBodyFrom: &svcapitypes.DashboardBodySource{},
+			},
+		},
+		{
+			name: "body with body from configmap",
+			spec: svcapitypes.DashboardSpec{
+				DashboardBody:     aws.String(`{"widgets":[]}`),
+				DashboardBodyFrom: &svcapitypes.DashboardBodySource{},
+			},
+			wants: []string{"spec.dashboardBody"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &svcapitypes.Dashboard{Spec: tt.spec}
+			errs, warnings := validateDashboardSpec(ko, field.NewPath("spec"))
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("validateDashboardSpec() warnings = %v, want %d", warnings, tt.wantWarnings)
+			}
+			if len(errs) != len(tt.wants) {
+				t.Fatalf("validateDashboardSpec() = %v, want errors on %v", errs, tt.wants)
+			}
+			for i, err := range errs {
+				if err.Field != tt.wants[i] {
+					t.Errorf("error %d on %s, want %s", i, err.Field, tt.wants[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	invalid := &svcapitypes.Dashboard{
+		Spec: svcapitypes.DashboardSpec{
+			DashboardBody: aws.String(`{"widgets":[{"type":"text","properties":{}}]}`),
+		},
+	}
+	deleting := invalid.DeepCopy()
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	changed := invalid.DeepCopy()
+	changed.Spec.DashboardBody = aws.String(`{"widgets":[{"type":"text","properties":{"foo":"bar"}}]}`)
+
+	tests := []struct {
+		name    string
+		newObj  *svcapitypes.Dashboard
+		wantErr bool
+	}{
+		{
+			name:   "unchanged spec",
+			newObj: invalid.DeepCopy(),
+		},
+		{
+			name:   "being deleted",
+			newObj: deleting,
+		},
+		{
+			name:    "changed spec",
+			newObj:  changed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&validator{}).ValidateUpdate(context.TODO(), invalid, tt.newObj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+	"k8s.io/apimachinery/pkg/api/equality"
 )
 
 // Hack to avoid import errors during build...
@@ -40,7 +41,18 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	a = withRenderedDashboardBody(a)
 
+	if len(a.ko.Spec.AlarmARNs) != len(b.ko.Spec.AlarmARNs) {
+		delta.Add("Spec.AlarmARNs", a.ko.Spec.AlarmARNs, b.ko.Spec.AlarmARNs)
+	} else if len(a.ko.Spec.AlarmARNs) > 0 {
+		if !ackcompare.SliceStringPEqual(a.ko.Spec.AlarmARNs, b.ko.Spec.AlarmARNs) {
+			delta.Add("Spec.AlarmARNs", a.ko.Spec.AlarmARNs, b.ko.Spec.AlarmARNs)
+		}
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.AlarmRefs, b.ko.Spec.AlarmRefs) {
+		delta.Add("Spec.AlarmRefs", a.ko.Spec.AlarmRefs, b.ko.Spec.AlarmRefs)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DashboardBody, b.ko.Spec.DashboardBody) {
 		delta.Add("Spec.DashboardBody", a.ko.Spec.DashboardBody, b.ko.Spec.DashboardBody)
 	} else if a.ko.Spec.DashboardBody != nil && b.ko.Spec.DashboardBody != nil {
@@ -55,6 +67,11 @@ func newResourceDelta(
 			delta.Add("Spec.DashboardName", a.ko.Spec.DashboardName, b.ko.Spec.DashboardName)
 		}
 	}
+	desiredACKTags, _ := convertToOrderedACKTags(a.ko.Spec.Tags)
+	latestACKTags, _ := convertToOrderedACKTags(b.ko.Spec.Tags)
+	if !ackcompare.MapStringStringEqual(desiredACKTags, latestACKTags) {
+		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+	}
 
 	return delta
 }
@@ -0,0 +1,196 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
+)
+
+// placeholderRegex matches the ${name} and ${namespace/name} placeholders
+// that refer to Variables or to MetricAlarm resources listed in AlarmRefs.
+var placeholderRegex = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// dashboardDeletions holds the deletion coalescer of each account and
+// region.
+var dashboardDeletions commonutil.DeletionCoalescers
+
+// deletions returns the deletion coalescer of the account and region of the
+// resource manager, which groups the dashboards to delete into
+// DeleteDashboards requests.
+func (rm *resourceManager) deletions() *commonutil.DeletionCoalescer {
+	return dashboardDeletions.For(string(rm.awsAccountID) + "/" + string(rm.awsRegion))
+}
+
+// setDashboardBody sets the DashboardBody of the PutDashboard input to the
+// body of the supplied resource, read from DashboardBodyFrom or rendered from
+// the Widgets, with the placeholders replaced.
+func (rm *resourceManager) setDashboardBody(
+	ctx context.Context,
+	input *svcsdk.PutDashboardInput,
+	r *resource,
+) error {
+	ko, err := rm.withDashboardBodyFrom(ctx, r.ko)
+	if err != nil {
+		return err
+	}
+	body, err := renderBody(ko)
+	if err != nil {
+		return err
+	}
+	input.DashboardBody = body
+	return nil
+}
+
+// validateRenderedBody checks the dashboard body of the PutDashboard input
+// with validateDashboardBody, so that a malformed body is not sent to
+// CloudWatch. When problems are found, it returns a copy of the supplied
+// resource with DashboardValidationMessages set to them, and a terminal
+// error. Widgets of unknown types are left for PutDashboard to validate.
+func validateRenderedBody(
+	input *svcsdk.PutDashboardInput,
+	r *resource,
+) (*resource, error) {
+	if input.DashboardBody == nil {
+		return nil, nil
+	}
+	messages, _ := validateDashboardBody(*input.DashboardBody)
+	if len(messages) == 0 {
+		return nil, nil
+	}
+	ko := r.ko.DeepCopy()
+	ko.Status.DashboardValidationMessages = messages
+	return &resource{ko}, ackerr.NewTerminalError(fmt.Errorf(
+		"dashboard body is invalid: %s", formatValidationMessages(messages),
+	))
+}
+
+// formatValidationMessages returns the supplied messages as a single
+// string, each one prefixed with its DataPath.
+func formatValidationMessages(messages []*svcapitypes.DashboardValidationMessage) string {
+	formatted := make([]string, 0, len(messages))
+	for _, m := range messages {
+		formatted = append(formatted, formatValidationMessage(m))
+	}
+	return strings.Join(formatted, "; ")
+}
+
+// formatValidationMessage returns the supplied message prefixed with its
+// DataPath.
+func formatValidationMessage(m *svcapitypes.DashboardValidationMessage) string {
+	if m.DataPath != nil && *m.DataPath != "" {
+		return *m.DataPath + ": " + *m.Message
+	}
+	return *m.Message
+}
+
+// withRenderedDashboardBody returns a copy of the supplied resource with the
+// DashboardBody rendered from its Widgets, Variables and AlarmRefs, so that
+// it is compared with the body stored in CloudWatch. The resource is returned
+// unchanged when it uses none of them or the body can't be rendered.
+func withRenderedDashboardBody(r *resource) *resource {
+	if r.ko.Spec.Widgets == nil && len(r.ko.Spec.Variables) == 0 && len(r.ko.Spec.AlarmRefs) == 0 {
+		return r
+	}
+	body, err := renderBody(r.ko)
+	if err != nil {
+		return r
+	}
+	ko := r.ko.DeepCopy()
+	ko.Spec.DashboardBody = body
+	return &resource{ko}
+}
+
+// renderBody returns the dashboard body sent to PutDashboard: either
+// DashboardBody, which withDashboardBodyFrom reads DashboardBodyFrom into,
+// or the body rendered from Widgets, with every placeholder replaced.
+func renderBody(ko *svcapitypes.Dashboard) (*string, error) {
+	body := ko.Spec.DashboardBody
+	if ko.Spec.Widgets != nil {
+		if body != nil {
+			return nil, ackerr.NewTerminalError(fmt.Errorf("widgets cannot be set with dashboardBody or dashboardBodyFrom"))
+		}
+		var err error
+		if body, err = renderDashboardBody(ko.Spec.Widgets); err != nil {
+			return nil, ackerr.NewTerminalError(err)
+		}
+	}
+	if body == nil {
+		return nil, ackerr.NewTerminalError(fmt.Errorf("one of dashboardBody, dashboardBodyFrom or widgets must be set"))
+	}
+	return renderPlaceholders(ko, *body)
+}
+
+// renderPlaceholders returns the dashboard body with every ${name} and
+// ${namespace/name} placeholder replaced by the value of the variable with
+// that name, or by the ARN of the matching MetricAlarm resolved from
+// AlarmRefs. The body is returned unchanged when the resource has neither
+// Variables nor AlarmRefs.
+func renderPlaceholders(ko *svcapitypes.Dashboard, body string) (*string, error) {
+	if len(ko.Spec.Variables) == 0 && len(ko.Spec.AlarmRefs) == 0 {
+		return &body, nil
+	}
+
+	// AlarmARNs is filled in the same order as AlarmRefs by
+	// resolveReferenceForAlarmARNs.
+	values := map[string]string{}
+	i := 0
+	for _, ref := range ko.Spec.AlarmRefs {
+		if ref == nil || ref.From == nil || ref.From.Name == nil {
+			continue
+		}
+		if i >= len(ko.Spec.AlarmARNs) || ko.Spec.AlarmARNs[i] == nil {
+			return nil, fmt.Errorf("alarm reference %q has not been resolved", *ref.From.Name)
+		}
+		namespace := ko.Namespace
+		if ref.From.Namespace != nil && *ref.From.Namespace != "" {
+			namespace = *ref.From.Namespace
+		}
+		values[namespace+"/"+*ref.From.Name] = *ko.Spec.AlarmARNs[i]
+		if namespace == ko.Namespace {
+			values[*ref.From.Name] = *ko.Spec.AlarmARNs[i]
+		}
+		i++
+	}
+	for name, value := range ko.Spec.Variables {
+		if value != nil {
+			values[name] = *value
+		}
+	}
+
+	var missing []string
+	rendered := placeholderRegex.ReplaceAllStringFunc(body, func(placeholder string) string {
+		key := placeholderRegex.FindStringSubmatch(placeholder)[1]
+		value, ok := values[key]
+		if !ok {
+			missing = append(missing, key)
+			return placeholder
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"dashboard references %q which is neither a variable nor listed in alarmRefs", missing[0],
+		))
+	}
+	return &rendered, nil
+}
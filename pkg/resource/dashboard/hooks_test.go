@@ -0,0 +1,162 @@
+package dashboard
+
+import (
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func alarmRef(namespace, name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	ref := &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+	if namespace != "" {
+		ref.From.Namespace = aws.String(namespace)
+	}
+	return ref
+}
+
+func TestRenderBody(t *testing.T) {
+	const cpuARN = "arn:aws:cloudwatch:us-west-2:111122223333:alarm:cpu-high"
+	const diskARN = "arn:aws:cloudwatch:us-west-2:111122223333:alarm:disk-full"
+
+	tests := []struct {
+		name      string
+		body      *string
+		widgets   []*svcapitypes.DashboardWidget
+		variables map[string]*string
+		refs      []*ackv1alpha1.AWSResourceReferenceWrapper
+		alarmARNs []*string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "no references",
+			body: aws.String(`{"widgets":[{"type":"alarm","properties":{"alarms":["${cpu}"]}}]}`),
+			want: `{"widgets":[{"type":"alarm","properties":{"alarms":["${cpu}"]}}]}`,
+		},
+		{
+			name:      "body placeholders",
+			body:      aws.String(`{"widgets":[{"type":"alarm","properties":{"alarms":["${cpu}","${other/disk}"]}}]}`),
+			refs:      []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu"), alarmRef("other", "disk")},
+			alarmARNs: []*string{aws.String(cpuARN), aws.String(diskARN)},
+			want:      `{"widgets":[{"type":"alarm","properties":{"alarms":["` + cpuARN + `","` + diskARN + `"]}}]}`,
+		},
+		{
+			name: "widget placeholders",
+			widgets: []*svcapitypes.DashboardWidget{
+				{Alarm: &svcapitypes.DashboardAlarmWidget{Alarms: []*string{aws.String("${default/cpu}")}}},
+			},
+			refs:      []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu")},
+			alarmARNs: []*string{aws.String(cpuARN)},
+			want:      `{"widgets":[{"properties":{"alarms":["` + cpuARN + `"]},"type":"alarm"}]}`,
+		},
+		{
+			name: "variables",
+			body: aws.String(`{"widgets":[{"type":"metric","properties":{"metrics":[["ContainerInsights","pod_cpu_utilization","ClusterName","${cluster}"]],"region":"${region}"}}]}`),
+			variables: map[string]*string{
+				"cluster": aws.String("prod"),
+				"region":  aws.String("us-west-2"),
+			},
+			want: `{"widgets":[{"type":"metric","properties":{"metrics":[["ContainerInsights","pod_cpu_utilization","ClusterName","prod"]],"region":"us-west-2"}}]}`,
+		},
+		{
+			name:      "variables and alarm placeholders",
+			body:      aws.String(`{"widgets":[{"type":"alarm","properties":{"alarms":["${cpu}"],"title":"${title}"}}]}`),
+			variables: map[string]*string{"title": aws.String("Alarms")},
+			refs:      []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu")},
+			alarmARNs: []*string{aws.String(cpuARN)},
+			want:      `{"widgets":[{"type":"alarm","properties":{"alarms":["` + cpuARN + `"],"title":"Alarms"}}]}`,
+		},
+		{
+			name:      "undeclared variable",
+			body:      aws.String(`{"widgets":[{"type":"text","properties":{"markdown":"${cluster}"}}]}`),
+			variables: map[string]*string{"region": aws.String("us-west-2")},
+			wantErr:   true,
+		},
+		{
+			name:      "placeholder not in alarmRefs",
+			body:      aws.String(`{"widgets":[{"type":"alarm","properties":{"alarms":["${disk}"]}}]}`),
+			refs:      []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu")},
+			alarmARNs: []*string{aws.String(cpuARN)},
+			wantErr:   true,
+		},
+		{
+			name:    "references not resolved",
+			body:    aws.String(`{"widgets":[{"type":"alarm","properties":{"alarms":["${cpu}"]}}]}`),
+			refs:    []*ackv1alpha1.AWSResourceReferenceWrapper{alarmRef("", "cpu")},
+			wantErr: true,
+		},
+		{
+			name:    "no body or widgets",
+			wantErr: true,
+		},
+		{
+			name: "body and widgets",
+			body: aws.String(`{"widgets":[]}`),
+			widgets: []*svcapitypes.DashboardWidget{
+				{Text: &svcapitypes.DashboardTextWidget{Markdown: aws.String("# Hello")}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &svcapitypes.Dashboard{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Spec: svcapitypes.DashboardSpec{
+					DashboardBody: tt.body,
+					Widgets:       tt.widgets,
+					Variables:     tt.variables,
+					AlarmRefs:     tt.refs,
+					AlarmARNs:     tt.alarmARNs,
+				},
+			}
+
+			got, err := renderBody(ko)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renderBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if *got != tt.want {
+				t.Errorf("renderBody() = %s, want %s", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRenderedBody(t *testing.T) {
+	desired := &resource{&svcapitypes.Dashboard{}}
+
+	invalid, err := validateRenderedBody(&svcsdk.PutDashboardInput{
+		DashboardBody: aws.String(`{"widgets":[{"type":"text","properties":{"markdown":"a"}}]}`),
+	}, desired)
+	if invalid != nil || err != nil {
+		t.Fatalf("validateRenderedBody() = %v, %v, want nil, nil", invalid, err)
+	}
+
+	invalid, err = validateRenderedBody(&svcsdk.PutDashboardInput{
+		DashboardBody: aws.String(`{"widgets":[{"type":"text","properties":{}}]}`),
+	}, desired)
+	var terminal *ackerr.TerminalError
+	if !errors.As(err, &terminal) {
+		t.Fatalf("validateRenderedBody() error = %v, want a terminal error", err)
+	}
+	messages := invalid.ko.Status.DashboardValidationMessages
+	if len(messages) != 1 || *messages[0].DataPath != "/widgets/0/properties" {
+		t.Errorf("DashboardValidationMessages = %v, want a message on /widgets/0/properties", messages)
+	}
+	if desired.ko.Status.DashboardValidationMessages != nil {
+		t.Errorf("validateRenderedBody() changed the desired resource")
+	}
+}
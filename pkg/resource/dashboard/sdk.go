@@ -30,11 +30,14 @@ import (
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	smithy "github.com/aws/smithy-go"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/cloudwatch-controller/pkg/drift"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
 )
 
 // Hack to avoid import errors during build...
@@ -107,6 +110,14 @@ func (rm *resourceManager) sdkFind(
 		ko.Spec.DashboardName = nil
 	}
 
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+	rm.omitDashboardBodyFrom(ctx, ko)
+	drift.Observe(&resourceDescriptor{}, &resource{ko})
 	rm.setStatusDefaults(ko)
 	return &resource{ko}, nil
 }
@@ -151,6 +162,12 @@ func (rm *resourceManager) sdkCreate(
 	if err != nil {
 		return nil, err
 	}
+	if err := rm.setDashboardBody(ctx, input, desired); err != nil {
+		return nil, err
+	}
+	if invalid, err := validateRenderedBody(input, desired); err != nil {
+		return invalid, err
+	}
 
 	var resp *svcsdk.PutDashboardOutput
 	_ = resp
@@ -198,6 +215,20 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.DashboardName != nil {
 		res.DashboardName = r.ko.Spec.DashboardName
 	}
+	if r.ko.Spec.Tags != nil {
+		f2 := []svcsdktypes.Tag{}
+		for _, f2iter := range r.ko.Spec.Tags {
+			f2elem := &svcsdktypes.Tag{}
+			if f2iter.Key != nil {
+				f2elem.Key = f2iter.Key
+			}
+			if f2iter.Value != nil {
+				f2elem.Value = f2iter.Value
+			}
+			f2 = append(f2, *f2elem)
+		}
+		res.Tags = f2
+	}
 
 	return res, nil
 }
@@ -215,10 +246,30 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	drift.Forget(&resourceDescriptor{}, desired)
+	if delta.DifferentAt("Spec.Tags") {
+		if err = commonutil.SyncTags(
+			ctx, rm.sdkapi, rm.metrics, latest.ko.Status.ACKResourceMetadata,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+			rm.cfg.ResourceTagKeys, convertToOrderedACKTags,
+			syncAWSTags, ignoreSystemTags,
+		); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.Tags") {
+		return desired, nil
+	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
 		return nil, err
 	}
+	if err := rm.setDashboardBody(ctx, input, desired); err != nil {
+		return nil, err
+	}
+	if invalid, err := validateRenderedBody(input, desired); err != nil {
+		return invalid, err
+	}
 
 	var resp *svcsdk.PutDashboardOutput
 	_ = resp
@@ -267,6 +318,20 @@ func (rm *resourceManager) newUpdateRequestPayload(
 	if r.ko.Spec.DashboardName != nil {
 		res.DashboardName = r.ko.Spec.DashboardName
 	}
+	if r.ko.Spec.Tags != nil {
+		f2 := []svcsdktypes.Tag{}
+		for _, f2iter := range r.ko.Spec.Tags {
+			f2elem := &svcsdktypes.Tag{}
+			if f2iter.Key != nil {
+				f2elem.Key = f2iter.Key
+			}
+			if f2iter.Value != nil {
+				f2elem.Value = f2iter.Value
+			}
+			f2 = append(f2, *f2elem)
+		}
+		res.Tags = f2
+	}
 
 	return res, nil
 }
@@ -285,12 +350,16 @@ func (rm *resourceManager) sdkDelete(
 	if err != nil {
 		return nil, err
 	}
-	input.DashboardNames = []string{*r.ko.Spec.DashboardName}
+	drift.Forget(&resourceDescriptor{}, r)
+	if input.DashboardNames, err = rm.deletions().Next(*r.ko.Spec.DashboardName); err != nil {
+		return nil, err
+	}
 
 	var resp *svcsdk.DeleteDashboardsOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteDashboards(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteDashboards", err)
+	err = rm.deletions().Done(input.DashboardNames, err)
 	return nil, err
 }
 
@@ -0,0 +1,156 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+
+	ackrtwebhook "github.com/aws-controllers-k8s/runtime/pkg/webhook"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-cloudwatch-services-k8s-aws-v1alpha1-dashboard,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloudwatch.services.k8s.aws,resources=dashboards,verbs=create;update,versions=v1alpha1,name=vdashboard.cloudwatch.services.k8s.aws,admissionReviewVersions=v1
+
+// validator rejects Dashboards whose body PutDashboard would refuse. The
+// body read from DashboardBodyFrom isn't known at admission time, it is
+// validated by sdkCreate and sdkUpdate before PutDashboard is called.
+type validator struct{}
+
+// ValidateCreate validates the spec of a Dashboard being created.
+func (v *validator) ValidateCreate(
+	ctx context.Context,
+	obj *svcapitypes.Dashboard,
+) (admission.Warnings, error) {
+	return validateDashboard(obj)
+}
+
+// ValidateUpdate validates the spec of a Dashboard being updated. Updates
+// leaving the spec unchanged, such as the status and finalizer updates of
+// the controller, and updates of a Dashboard being deleted are allowed.
+func (v *validator) ValidateUpdate(
+	ctx context.Context,
+	oldObj *svcapitypes.Dashboard,
+	newObj *svcapitypes.Dashboard,
+) (admission.Warnings, error) {
+	if newObj.DeletionTimestamp != nil || equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	return validateDashboard(newObj)
+}
+
+// ValidateDelete allows every Dashboard to be deleted.
+func (v *validator) ValidateDelete(
+	ctx context.Context,
+	obj *svcapitypes.Dashboard,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateDashboard returns the warnings found in the spec of the supplied
+// Dashboard, and an Invalid API error listing every problem found in it, or
+// nil if there is none.
+func validateDashboard(ko *svcapitypes.Dashboard) (admission.Warnings, error) {
+	errs, warnings := validateDashboardSpec(ko, field.NewPath("spec"))
+	if len(errs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupKind.Group, Kind: GroupKind.Kind},
+		ko.Name,
+		errs,
+	)
+}
+
+// validateDashboardSpec checks the body set in DashboardBody or rendered
+// from Widgets with validateDashboardBody. Each DashboardValidationMessage
+// is returned as an error on the field the body comes from, and each
+// warning as an admission warning.
+func validateDashboardSpec(ko *svcapitypes.Dashboard, path *field.Path) (field.ErrorList, admission.Warnings) {
+	spec := &ko.Spec
+	var body *string
+	var bodyPath *field.Path
+	switch {
+	case spec.Widgets != nil:
+		bodyPath = path.Child("widgets")
+		if spec.DashboardBody != nil || spec.DashboardBodyFrom != nil {
+			return field.ErrorList{field.Forbidden(
+				bodyPath, "may not be set together with dashboardBody or dashboardBodyFrom",
+			)}, nil
+		}
+		var err error
+		if body, err = renderDashboardBody(spec.Widgets); err != nil {
+			return field.ErrorList{field.Invalid(bodyPath, field.OmitValueType{}, err.Error())}, nil
+		}
+	case spec.DashboardBody != nil:
+		bodyPath = path.Child("dashboardBody")
+		if spec.DashboardBodyFrom != nil {
+			return field.ErrorList{field.Forbidden(
+				bodyPath, "may not be set together with dashboardBodyFrom",
+			)}, nil
+		}
+		body = spec.DashboardBody
+	default:
+		return nil, nil
+	}
+
+	messages, warnings := validateDashboardBody(renderVariables(ko, *body))
+	errs := field.ErrorList{}
+	for _, m := range messages {
+		errs = append(errs, field.Invalid(bodyPath, field.OmitValueType{}, formatValidationMessage(m)))
+	}
+	var admissionWarnings admission.Warnings
+	for _, m := range warnings {
+		admissionWarnings = append(admissionWarnings, bodyPath.String()+": "+formatValidationMessage(m))
+	}
+	return errs, admissionWarnings
+}
+
+// renderVariables returns the dashboard body with the ${name} placeholders
+// of Variables replaced. Placeholders referring to AlarmRefs are left as
+// they are, since the alarms aren't resolved at admission time.
+func renderVariables(ko *svcapitypes.Dashboard, body string) string {
+	return placeholderRegex.ReplaceAllStringFunc(body, func(placeholder string) string {
+		key := placeholderRegex.FindStringSubmatch(placeholder)[1]
+		if value, ok := ko.Spec.Variables[key]; ok && value != nil {
+			return *value
+		}
+		return placeholder
+	})
+}
+
+func init() {
+	webhook := ackrtwebhook.New(
+		"v1alpha1",
+		GroupKind.Kind,
+		"validating",
+		func(mgr ctrlrt.Manager) error {
+			return ctrlrt.NewWebhookManagedBy(
+				mgr, &svcapitypes.Dashboard{},
+			).WithValidator(
+				&validator{},
+			).Complete()
+		},
+	)
+	if err := ackrtwebhook.RegisterWebhook(webhook); err != nil {
+		panic(fmt.Sprintf("cannot register webhook: %v", err))
+	}
+}
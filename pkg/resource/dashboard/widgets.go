@@ -0,0 +1,229 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// The widget types of a dashboard body.
+const (
+	widgetTypeAlarm    = "alarm"
+	widgetTypeExplorer = "explorer"
+	widgetTypeLog      = "log"
+	widgetTypeMetric   = "metric"
+	widgetTypeText     = "text"
+)
+
+// renderDashboardBody returns the dashboard body JSON document for the
+// supplied widgets. Keys are written in lexical order, so that the same
+// widgets always render to the same body.
+func renderDashboardBody(widgets []*svcapitypes.DashboardWidget) (*string, error) {
+	rendered := make([]map[string]any, 0, len(widgets))
+	for i, w := range widgets {
+		widget, err := renderWidget(w)
+		if err != nil {
+			return nil, fmt.Errorf("widgets[%d]: %w", i, err)
+		}
+		rendered = append(rendered, widget)
+	}
+	b, err := json.Marshal(map[string]any{"widgets": rendered})
+	if err != nil {
+		return nil, err
+	}
+	body := string(b)
+	return &body, nil
+}
+
+// renderWidget returns the dashboard body representation of a single widget.
+func renderWidget(w *svcapitypes.DashboardWidget) (map[string]any, error) {
+	if w == nil {
+		return nil, fmt.Errorf("widget is empty")
+	}
+
+	var types []string
+	var properties any
+	var err error
+	if w.Alarm != nil {
+		types = append(types, widgetTypeAlarm)
+		properties, err = renderAlarmWidget(w.Alarm)
+	}
+	if w.Explorer != nil {
+		types = append(types, widgetTypeExplorer)
+		properties, err = renderExplorerWidget(w.Explorer)
+	}
+	if w.Log != nil {
+		types = append(types, widgetTypeLog)
+		properties, err = renderLogWidget(w.Log)
+	}
+	if w.Metric != nil {
+		types = append(types, widgetTypeMetric)
+		properties, err = renderMetricWidget(w.Metric)
+	}
+	if w.Text != nil {
+		types = append(types, widgetTypeText)
+		properties, err = renderTextWidget(w.Text)
+	}
+	switch {
+	case len(types) == 0:
+		return nil, fmt.Errorf("one of alarm, explorer, log, metric or text must be set")
+	case len(types) > 1:
+		return nil, fmt.Errorf("only one of alarm, explorer, log, metric or text can be set, found %s", strings.Join(types, ", "))
+	case err != nil:
+		return nil, fmt.Errorf("%s: %w", types[0], err)
+	}
+
+	widget := map[string]any{
+		"type":       types[0],
+		"properties": properties,
+	}
+	putValue(widget, "x", w.X)
+	putValue(widget, "y", w.Y)
+	putValue(widget, "width", w.Width)
+	putValue(widget, "height", w.Height)
+	return widget, nil
+}
+
+func renderAlarmWidget(w *svcapitypes.DashboardAlarmWidget) (any, error) {
+	if len(w.Alarms) == 0 {
+		return nil, fmt.Errorf("alarms must be set")
+	}
+	return w, nil
+}
+
+func renderLogWidget(w *svcapitypes.DashboardLogWidget) (any, error) {
+	if w.Query == nil || *w.Query == "" {
+		return nil, fmt.Errorf("query must be set")
+	}
+	return w, nil
+}
+
+func renderTextWidget(w *svcapitypes.DashboardTextWidget) (any, error) {
+	if w.Markdown == nil {
+		return nil, fmt.Errorf("markdown must be set")
+	}
+	return w, nil
+}
+
+func renderExplorerWidget(w *svcapitypes.DashboardExplorerWidget) (any, error) {
+	if len(w.Metrics) == 0 {
+		return nil, fmt.Errorf("metrics must be set")
+	}
+	properties := map[string]any{
+		"metrics": w.Metrics,
+	}
+	if len(w.Labels) > 0 {
+		properties["labels"] = w.Labels
+	}
+	if w.AggregateBy != nil {
+		properties["aggregateBy"] = w.AggregateBy
+	}
+	putValue(properties, "period", w.Period)
+	putValue(properties, "region", w.Region)
+	putValue(properties, "splitBy", w.SplitBy)
+	putValue(properties, "title", w.Title)
+
+	// The display options of explorer widgets are nested in widgetOptions.
+	options := map[string]any{}
+	putValue(options, "rowsPerPage", w.RowsPerPage)
+	putValue(options, "stacked", w.Stacked)
+	putValue(options, "view", w.View)
+	putValue(options, "widgetsPerRow", w.WidgetsPerRow)
+	if len(options) > 0 {
+		properties["widgetOptions"] = options
+	}
+	return properties, nil
+}
+
+func renderMetricWidget(w *svcapitypes.DashboardMetricWidget) (any, error) {
+	if len(w.Metrics) == 0 {
+		return nil, fmt.Errorf("metrics must be set")
+	}
+	metrics := make([][]any, 0, len(w.Metrics))
+	for i, m := range w.Metrics {
+		metric, err := renderMetric(m)
+		if err != nil {
+			return nil, fmt.Errorf("metrics[%d]: %w", i, err)
+		}
+		metrics = append(metrics, metric)
+	}
+	properties := map[string]any{
+		"metrics": metrics,
+	}
+	putValue(properties, "liveData", w.LiveData)
+	putValue(properties, "period", w.Period)
+	putValue(properties, "region", w.Region)
+	putValue(properties, "setPeriodToTimeRange", w.SetPeriodToTimeRange)
+	putValue(properties, "sparkline", w.Sparkline)
+	putValue(properties, "stacked", w.Stacked)
+	putValue(properties, "stat", w.Stat)
+	putValue(properties, "timezone", w.Timezone)
+	putValue(properties, "title", w.Title)
+	putValue(properties, "view", w.View)
+	return properties, nil
+}
+
+// renderMetric returns the array form of a metric used in the metrics of a
+// metric widget: the namespace, the metric name and the dimension name/value
+// pairs, followed by the rendering options. A metric math expression is an
+// array holding only the options.
+func renderMetric(m *svcapitypes.DashboardMetric) ([]any, error) {
+	if m == nil {
+		return nil, fmt.Errorf("metric is empty")
+	}
+	options := map[string]any{}
+	putValue(options, "accountId", m.AccountID)
+	putValue(options, "color", m.Color)
+	putValue(options, "id", m.ID)
+	putValue(options, "label", m.Label)
+	putValue(options, "period", m.Period)
+	putValue(options, "region", m.Region)
+	putValue(options, "stat", m.Stat)
+	putValue(options, "visible", m.Visible)
+	putValue(options, "yAxis", m.YAxis)
+
+	if m.Expression != nil {
+		if m.Namespace != nil || m.MetricName != nil || len(m.Dimensions) > 0 {
+			return nil, fmt.Errorf("expression cannot be set with namespace, metricName or dimensions")
+		}
+		options["expression"] = *m.Expression
+		return []any{options}, nil
+	}
+
+	if m.Namespace == nil || m.MetricName == nil {
+		return nil, fmt.Errorf("namespace and metricName, or expression must be set")
+	}
+	metric := []any{*m.Namespace, *m.MetricName}
+	for _, d := range m.Dimensions {
+		if d == nil || d.Name == nil || d.Value == nil {
+			return nil, fmt.Errorf("dimensions must have a name and a value")
+		}
+		metric = append(metric, *d.Name, *d.Value)
+	}
+	if len(options) > 0 {
+		metric = append(metric, options)
+	}
+	return metric, nil
+}
+
+// putValue sets m[key] to the value pointed to by v, unless v is nil.
+func putValue[T any](m map[string]any, key string, v *T) {
+	if v != nil {
+		m[key] = *v
+	}
+}
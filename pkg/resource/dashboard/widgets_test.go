@@ -0,0 +1,139 @@
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func TestRenderDashboardBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		widgets []*svcapitypes.DashboardWidget
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "metric widget",
+			widgets: []*svcapitypes.DashboardWidget{{
+				X: aws.Int64(0), Y: aws.Int64(0), Width: aws.Int64(12), Height: aws.Int64(6),
+				Metric: &svcapitypes.DashboardMetricWidget{
+					Metrics: []*svcapitypes.DashboardMetric{
+						{
+							Namespace:  aws.String("AWS/EC2"),
+							MetricName: aws.String("CPUUtilization"),
+							Dimensions: []*svcapitypes.Dimension{{Name: aws.String("InstanceId"), Value: aws.String("i-1234567890abcdef0")}},
+							ID:         aws.String("m1"),
+						},
+						{Expression: aws.String("m1 * 2"), Label: aws.String("double")},
+					},
+					Period: aws.Int64(300),
+					Stat:   aws.String("Average"),
+					Region: aws.String("us-west-2"),
+				},
+			}},
+			want: `{"widgets":[{"height":6,"properties":{"metrics":[["AWS/EC2","CPUUtilization","InstanceId","i-1234567890abcdef0",{"id":"m1"}],[{"expression":"m1 * 2","label":"double"}]],"period":300,"region":"us-west-2","stat":"Average"},"type":"metric","width":12,"x":0,"y":0}]}`,
+		},
+		{
+			name: "text and alarm widgets",
+			widgets: []*svcapitypes.DashboardWidget{
+				{Text: &svcapitypes.DashboardTextWidget{Markdown: aws.String("# Hello")}},
+				{Alarm: &svcapitypes.DashboardAlarmWidget{Alarms: []*string{aws.String("arn:aws:cloudwatch:us-west-2:111122223333:alarm:cpu")}}},
+			},
+			want: `{"widgets":[{"properties":{"markdown":"# Hello"},"type":"text"},{"properties":{"alarms":["arn:aws:cloudwatch:us-west-2:111122223333:alarm:cpu"]},"type":"alarm"}]}`,
+		},
+		{
+			name: "log and explorer widgets",
+			widgets: []*svcapitypes.DashboardWidget{
+				{Log: &svcapitypes.DashboardLogWidget{Query: aws.String("SOURCE 'app' | fields @message"), View: aws.String("table")}},
+				{Explorer: &svcapitypes.DashboardExplorerWidget{
+					Metrics: []*svcapitypes.DashboardExplorerMetric{{MetricName: aws.String("CPUUtilization"), ResourceType: aws.String("AWS::EC2::Instance"), Stat: aws.String("Average")}},
+					Labels:  []*svcapitypes.DashboardExplorerLabel{{Key: aws.String("env"), Value: aws.String("prod")}},
+					View:    aws.String("timeSeries"),
+				}},
+			},
+			want: `{"widgets":[{"properties":{"query":"SOURCE 'app' | fields @message","view":"table"},"type":"log"},{"properties":{"labels":[{"key":"env","value":"prod"}],"metrics":[{"metricName":"CPUUtilization","resourceType":"AWS::EC2::Instance","stat":"Average"}],"widgetOptions":{"view":"timeSeries"}},"type":"explorer"}]}`,
+		},
+		{
+			name:    "no widget type",
+			widgets: []*svcapitypes.DashboardWidget{{Width: aws.Int64(6)}},
+			wantErr: true,
+		},
+		{
+			name: "several widget types",
+			widgets: []*svcapitypes.DashboardWidget{{
+				Text: &svcapitypes.DashboardTextWidget{Markdown: aws.String("# Hello")},
+				Log:  &svcapitypes.DashboardLogWidget{Query: aws.String("SOURCE 'app'")},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "expression with metric name",
+			widgets: []*svcapitypes.DashboardWidget{{
+				Metric: &svcapitypes.DashboardMetricWidget{
+					Metrics: []*svcapitypes.DashboardMetric{{Expression: aws.String("m1"), MetricName: aws.String("CPUUtilization")}},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "missing markdown",
+			widgets: []*svcapitypes.DashboardWidget{{Text: &svcapitypes.DashboardTextWidget{}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderDashboardBody(tt.widgets)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renderDashboardBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if *got != tt.want {
+				t.Errorf("renderDashboardBody() = %s, want %s", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewResourceDelta_Widgets(t *testing.T) {
+	widgets := []*svcapitypes.DashboardWidget{
+		{Text: &svcapitypes.DashboardTextWidget{Markdown: aws.String("# Hello")}},
+	}
+	tests := []struct {
+		name     string
+		bodyB    *string
+		wantDiff bool
+	}{
+		{
+			name:     "rendered body matches",
+			bodyB:    aws.String(`{"widgets": [{"type": "text", "properties": {"markdown": "# Hello"}}]}`),
+			wantDiff: false,
+		},
+		{
+			name:     "rendered body differs",
+			bodyB:    aws.String(`{"widgets": [{"type": "text", "properties": {"markdown": "# Bye"}}]}`),
+			wantDiff: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &resource{ko: &svcapitypes.Dashboard{Spec: svcapitypes.DashboardSpec{Widgets: widgets}}}
+			b := &resource{ko: &svcapitypes.Dashboard{Spec: svcapitypes.DashboardSpec{Widgets: widgets, DashboardBody: tt.bodyB}}}
+
+			delta := newResourceDelta(a, b)
+			if got := delta.DifferentAt("Spec.DashboardBody"); got != tt.wantDiff {
+				t.Errorf("newResourceDelta() difference = %v, want %v", got, tt.wantDiff)
+			}
+			if a.ko.Spec.DashboardBody != nil {
+				t.Errorf("newResourceDelta() modified the desired resource")
+			}
+		})
+	}
+}
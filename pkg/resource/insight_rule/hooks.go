@@ -0,0 +1,166 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package insight_rule
+
+import (
+	"context"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
+)
+
+// The values of InsightRule.Spec.RuleState, as returned by
+// DescribeInsightRules.
+const (
+	ruleStateEnabled  = "ENABLED"
+	ruleStateDisabled = "DISABLED"
+)
+
+// customFindInsightRule reads the rule with DescribeInsightRules. The
+// operation can't be filtered by name, so every page is read until the rule
+// with the name in the spec is found.
+func (rm *resourceManager) customFindInsightRule(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindInsightRule")
+	defer func() {
+		exit(err)
+	}()
+
+	// If any required fields in the input shape are missing, AWS resource is
+	// not created yet. Return NotFound here to indicate to callers that the
+	// resource isn't yet created.
+	if r.ko.Spec.Name == nil {
+		return nil, ackerr.NotFound
+	}
+
+	input := &svcsdk.DescribeInsightRulesInput{}
+	var found *svcsdktypes.InsightRule
+	for found == nil {
+		var resp *svcsdk.DescribeInsightRulesOutput
+		resp, err = rm.sdkapi.DescribeInsightRules(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeInsightRules", err)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.InsightRules {
+			if aws.ToString(resp.InsightRules[i].Name) == *r.ko.Spec.Name {
+				found = &resp.InsightRules[i]
+				break
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	if found == nil {
+		return nil, ackerr.NotFound
+	}
+
+	// Merge in the information we read from the API call above to the copy of
+	// the original Kubernetes object we passed to the function
+	ko := r.ko.DeepCopy()
+
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	arn := ackv1alpha1.AWSResourceName(commonutil.InsightRuleARN(
+		rm.awsPartition, rm.awsRegion, rm.awsAccountID, *found.Name,
+	))
+	ko.Status.ACKResourceMetadata.ARN = &arn
+	ko.Spec.RuleDefinition = found.Definition
+	ko.Spec.RuleState = found.State
+	ko.Status.Schema = found.Schema
+	// ApplyOnTransformedLogs defaults to false, don't report it as a
+	// difference when it isn't set in the spec.
+	ko.Spec.ApplyOnTransformedLogs = found.ApplyOnTransformedLogs
+	if r.ko.Spec.ApplyOnTransformedLogs == nil && !aws.ToBool(found.ApplyOnTransformedLogs) {
+		ko.Spec.ApplyOnTransformedLogs = nil
+	}
+
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// updateRuleState enables or disables the rule according to
+// r.ko.Spec.RuleState.
+func (rm *resourceManager) updateRuleState(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.updateRuleState")
+	defer func() { exit(err) }()
+
+	names := []string{*r.ko.Spec.Name}
+	var failures []svcsdktypes.PartialFailure
+	switch *r.ko.Spec.RuleState {
+	case ruleStateEnabled:
+		var resp *svcsdk.EnableInsightRulesOutput
+		resp, err = rm.sdkapi.EnableInsightRules(ctx, &svcsdk.EnableInsightRulesInput{RuleNames: names})
+		rm.metrics.RecordAPICall("UPDATE", "EnableInsightRules", err)
+		if err == nil {
+			failures = resp.Failures
+		}
+	case ruleStateDisabled:
+		var resp *svcsdk.DisableInsightRulesOutput
+		resp, err = rm.sdkapi.DisableInsightRules(ctx, &svcsdk.DisableInsightRulesInput{RuleNames: names})
+		rm.metrics.RecordAPICall("UPDATE", "DisableInsightRules", err)
+		if err == nil {
+			failures = resp.Failures
+		}
+	default:
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"invalid ruleState %q, must be %q or %q",
+			*r.ko.Spec.RuleState, ruleStateEnabled, ruleStateDisabled,
+		))
+	}
+	if err != nil {
+		return err
+	}
+	return commonutil.InsightRuleFailure(failures)
+}
+
+// compareRuleState adds a difference at Spec.RuleState only when the desired
+// state is set, so that rules without a desired state are left in whatever
+// state they are in.
+func compareRuleState(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.RuleState == nil {
+		return
+	}
+	if b.ko.Spec.RuleState == nil || *a.ko.Spec.RuleState != *b.ko.Spec.RuleState {
+		delta.Add("Spec.RuleState", a.ko.Spec.RuleState, b.ko.Spec.RuleState)
+	}
+}
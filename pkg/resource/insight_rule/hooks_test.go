@@ -0,0 +1,79 @@
+package insight_rule
+
+import (
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func TestCompareRuleState(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  *string
+		latest   *string
+		wantDiff bool
+	}{
+		{
+			name:   "desired state not set",
+			latest: aws.String("ENABLED"),
+		},
+		{
+			name:    "same state",
+			desired: aws.String("DISABLED"),
+			latest:  aws.String("DISABLED"),
+		},
+		{
+			name:     "different state",
+			desired:  aws.String("DISABLED"),
+			latest:   aws.String("ENABLED"),
+			wantDiff: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &resource{&svcapitypes.InsightRule{Spec: svcapitypes.InsightRuleSpec{RuleState: tt.desired}}}
+			b := &resource{&svcapitypes.InsightRule{Spec: svcapitypes.InsightRuleSpec{RuleState: tt.latest}}}
+			delta := ackcompare.NewDelta()
+			compareRuleState(delta, a, b)
+			if got := delta.DifferentAt("Spec.RuleState"); got != tt.wantDiff {
+				t.Errorf("compareRuleState() difference = %v, want %v", got, tt.wantDiff)
+			}
+		})
+	}
+}
+
+func TestNewResourceDelta_RuleDefinition(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  string
+		latest   string
+		wantDiff bool
+	}{
+		{
+			name:    "same definition formatted differently",
+			desired: `{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["vpc-flow-logs"]}`,
+			latest:  `{"LogGroupNames":["vpc-flow-logs"],"Schema":{"Name":"CloudWatchLogRule","Version":1}}`,
+		},
+		{
+			name:     "different log groups",
+			desired:  `{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["vpc-flow-logs"]}`,
+			latest:   `{"Schema": {"Name": "CloudWatchLogRule", "Version": 1}, "LogGroupNames": ["api-gateway"]}`,
+			wantDiff: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &resource{&svcapitypes.InsightRule{Spec: svcapitypes.InsightRuleSpec{RuleDefinition: aws.String(tt.desired)}}}
+			b := &resource{&svcapitypes.InsightRule{Spec: svcapitypes.InsightRuleSpec{RuleDefinition: aws.String(tt.latest)}}}
+			delta := newResourceDelta(a, b)
+			if got := delta.DifferentAt("Spec.RuleDefinition"); got != tt.wantDiff {
+				t.Errorf("newResourceDelta() difference = %v, want %v", got, tt.wantDiff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package log_alarm
+
+import (
+	"context"
+	"errors"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
+)
+
+// customFindLogAlarm reads the log alarm with DescribeAlarms. The generated
+// ReadMany code can't be used here because DescribeAlarms is bound to
+// MetricAlarm and only returns the MetricAlarms wrapper.
+func (rm *resourceManager) customFindLogAlarm(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindLogAlarm")
+	defer func() {
+		exit(err)
+	}()
+
+	// If any required fields in the input shape are missing, AWS resource is
+	// not created yet. Return NotFound here to indicate to callers that the
+	// resource isn't yet created.
+	if r.ko.Spec.Name == nil {
+		return nil, ackerr.NotFound
+	}
+
+	input := &svcsdk.DescribeAlarmsInput{
+		AlarmNames: []string{*r.ko.Spec.Name},
+		AlarmTypes: []svcsdktypes.AlarmType{svcsdktypes.AlarmTypeLogAlarm},
+	}
+
+	var resp *svcsdk.DescribeAlarmsOutput
+	resp, err = rm.sdkapi.DescribeAlarms(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeAlarms", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "UNKNOWN" {
+			return nil, ackerr.NotFound
+		}
+		return nil, err
+	}
+
+	// Merge in the information we read from the API call above to the copy of
+	// the original Kubernetes object we passed to the function
+	ko := r.ko.DeepCopy()
+
+	found := false
+	for _, elem := range resp.LogAlarms {
+		if elem.AlarmName == nil || *elem.AlarmName != *r.ko.Spec.Name {
+			continue
+		}
+		setLogAlarm(ko, &elem)
+		found = true
+		break
+	}
+	if !found {
+		return nil, ackerr.NotFound
+	}
+
+	// DescribeAlarms doesn't return the tags of the scheduled query, and only
+	// returns the log group identifiers and role ARN the references were
+	// resolved to. Keep them from the spec.
+	if ko.Spec.ScheduledQueryConfiguration != nil && r.ko.Spec.ScheduledQueryConfiguration != nil {
+		ko.Spec.ScheduledQueryConfiguration.LogGroupRefs = r.ko.Spec.ScheduledQueryConfiguration.LogGroupRefs
+		ko.Spec.ScheduledQueryConfiguration.ScheduledQueryRoleRef = r.ko.Spec.ScheduledQueryConfiguration.ScheduledQueryRoleRef
+		ko.Spec.ScheduledQueryConfiguration.Tags = r.ko.Spec.ScheduledQueryConfiguration.Tags
+	}
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// setLogAlarm sets the Spec and Status fields of the supplied LogAlarm from
+// the log alarm returned by DescribeAlarms.
+func setLogAlarm(ko *svcapitypes.LogAlarm, elem *svcsdktypes.LogAlarm) {
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if elem.AlarmArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*elem.AlarmArn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if elem.ActionLogLineCount != nil {
+		actionLogLineCountCopy := int64(*elem.ActionLogLineCount)
+		ko.Spec.ActionLogLineCount = &actionLogLineCountCopy
+	} else {
+		ko.Spec.ActionLogLineCount = nil
+	}
+	ko.Spec.ActionLogLineRoleARN = elem.ActionLogLineRoleArn
+	ko.Spec.ActionsEnabled = elem.ActionsEnabled
+	if elem.AlarmActions != nil {
+		ko.Spec.AlarmActions = aws.StringSlice(elem.AlarmActions)
+	} else {
+		ko.Spec.AlarmActions = nil
+	}
+	if elem.AlarmConfigurationUpdatedTimestamp != nil {
+		ko.Status.AlarmConfigurationUpdatedTimestamp = &metav1.Time{Time: *elem.AlarmConfigurationUpdatedTimestamp}
+	} else {
+		ko.Status.AlarmConfigurationUpdatedTimestamp = nil
+	}
+	ko.Spec.AlarmDescription = elem.AlarmDescription
+	if elem.ComparisonOperator != "" {
+		ko.Spec.ComparisonOperator = aws.String(string(elem.ComparisonOperator))
+	} else {
+		ko.Spec.ComparisonOperator = nil
+	}
+	if elem.EvaluationState != "" {
+		ko.Status.EvaluationState = aws.String(string(elem.EvaluationState))
+	} else {
+		ko.Status.EvaluationState = nil
+	}
+	if elem.InsufficientDataActions != nil {
+		ko.Spec.InsufficientDataActions = aws.StringSlice(elem.InsufficientDataActions)
+	} else {
+		ko.Spec.InsufficientDataActions = nil
+	}
+	if elem.OKActions != nil {
+		ko.Spec.OKActions = aws.StringSlice(elem.OKActions)
+	} else {
+		ko.Spec.OKActions = nil
+	}
+	if elem.QueryResultsToAlarm != nil {
+		queryResultsToAlarmCopy := int64(*elem.QueryResultsToAlarm)
+		ko.Spec.QueryResultsToAlarm = &queryResultsToAlarmCopy
+	} else {
+		ko.Spec.QueryResultsToAlarm = nil
+	}
+	if elem.QueryResultsToEvaluate != nil {
+		queryResultsToEvaluateCopy := int64(*elem.QueryResultsToEvaluate)
+		ko.Spec.QueryResultsToEvaluate = &queryResultsToEvaluateCopy
+	} else {
+		ko.Spec.QueryResultsToEvaluate = nil
+	}
+	ko.Status.QueryARN = nil
+	if elem.ScheduledQueryConfiguration != nil {
+		config := &svcapitypes.ScheduledQueryConfiguration{
+			AggregationExpression: elem.ScheduledQueryConfiguration.AggregationExpression,
+			QueryString:           elem.ScheduledQueryConfiguration.QueryString,
+			ScheduledQueryRoleARN: elem.ScheduledQueryConfiguration.ScheduledQueryRoleARN,
+		}
+		if elem.ScheduledQueryConfiguration.LogGroupIdentifiers != nil {
+			config.LogGroupIdentifiers = aws.StringSlice(elem.ScheduledQueryConfiguration.LogGroupIdentifiers)
+		}
+		if schedule := elem.ScheduledQueryConfiguration.ScheduleConfiguration; schedule != nil {
+			config.ScheduleConfiguration = &svcapitypes.ScheduleConfiguration{
+				EndTimeOffset:      schedule.EndTimeOffset,
+				ScheduleExpression: schedule.ScheduleExpression,
+				StartTimeOffset:    schedule.StartTimeOffset,
+			}
+		}
+		ko.Spec.ScheduledQueryConfiguration = config
+		ko.Status.QueryARN = elem.ScheduledQueryConfiguration.QueryARN
+	} else {
+		ko.Spec.ScheduledQueryConfiguration = nil
+	}
+	ko.Status.StateReason = elem.StateReason
+	ko.Status.StateReasonData = elem.StateReasonData
+	if elem.StateTransitionedTimestamp != nil {
+		ko.Status.StateTransitionedTimestamp = &metav1.Time{Time: *elem.StateTransitionedTimestamp}
+	} else {
+		ko.Status.StateTransitionedTimestamp = nil
+	}
+	if elem.StateUpdatedTimestamp != nil {
+		ko.Status.StateUpdatedTimestamp = &metav1.Time{Time: *elem.StateUpdatedTimestamp}
+	} else {
+		ko.Status.StateUpdatedTimestamp = nil
+	}
+	if elem.StateValue != "" {
+		ko.Status.StateValue = aws.String(string(elem.StateValue))
+	} else {
+		ko.Status.StateValue = nil
+	}
+	ko.Spec.Threshold = elem.Threshold
+	ko.Spec.TreatMissingData = elem.TreatMissingData
+}
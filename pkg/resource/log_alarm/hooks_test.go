@@ -0,0 +1,78 @@
+package log_alarm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func TestSetLogAlarm(t *testing.T) {
+	const (
+		alarmARN = "arn:aws:cloudwatch:us-west-2:111122223333:alarm:errors"
+		queryARN = "arn:aws:logs:us-west-2:111122223333:scheduled-query:0123"
+	)
+	transitioned := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	ko := &svcapitypes.LogAlarm{}
+	setLogAlarm(ko, &svcsdktypes.LogAlarm{
+		AlarmArn:               aws.String(alarmARN),
+		AlarmName:              aws.String("errors"),
+		ComparisonOperator:     svcsdktypes.ComparisonOperatorGreaterThanThreshold,
+		QueryResultsToAlarm:    aws.Int32(2),
+		QueryResultsToEvaluate: aws.Int32(3),
+		ScheduledQueryConfiguration: &svcsdktypes.ScheduledQueryConfiguration{
+			AggregationExpression: aws.String("count(*)"),
+			LogGroupIdentifiers:   []string{"/aws/lambda/orders"},
+			QueryARN:              aws.String(queryARN),
+			QueryString:           aws.String("fields @message | filter @message like /ERROR/"),
+			ScheduleConfiguration: &svcsdktypes.ScheduleConfiguration{
+				EndTimeOffset:      aws.Int64(0),
+				ScheduleExpression: aws.String("rate(5 minutes)"),
+				StartTimeOffset:    aws.Int64(300),
+			},
+			ScheduledQueryRoleARN: aws.String("arn:aws:iam::111122223333:role/scheduled-query"),
+		},
+		StateTransitionedTimestamp: &transitioned,
+		StateValue:                 svcsdktypes.StateValueOk,
+		Threshold:                  aws.Float64(10),
+	})
+
+	if got := string(*ko.Status.ACKResourceMetadata.ARN); got != alarmARN {
+		t.Errorf("ARN = %s, want %s", got, alarmARN)
+	}
+	if got := aws.ToString(ko.Spec.ComparisonOperator); got != "GreaterThanThreshold" {
+		t.Errorf("ComparisonOperator = %s, want GreaterThanThreshold", got)
+	}
+	if got := aws.ToInt64(ko.Spec.QueryResultsToAlarm); got != 2 {
+		t.Errorf("QueryResultsToAlarm = %d, want 2", got)
+	}
+	if got := aws.ToInt64(ko.Spec.QueryResultsToEvaluate); got != 3 {
+		t.Errorf("QueryResultsToEvaluate = %d, want 3", got)
+	}
+	config := ko.Spec.ScheduledQueryConfiguration
+	if config == nil || config.ScheduleConfiguration == nil {
+		t.Fatalf("ScheduledQueryConfiguration = %v, want the schedule", config)
+	}
+	if config.QueryARN != nil {
+		t.Errorf("Spec QueryARN = %s, want it moved to the status", *config.QueryARN)
+	}
+	if got := aws.ToString(ko.Status.QueryARN); got != queryARN {
+		t.Errorf("Status.QueryARN = %s, want %s", got, queryARN)
+	}
+	if got := aws.ToInt64(config.ScheduleConfiguration.StartTimeOffset); got != 300 {
+		t.Errorf("StartTimeOffset = %d, want 300", got)
+	}
+	if got := aws.ToString(ko.Status.StateValue); got != "OK" {
+		t.Errorf("StateValue = %s, want OK", got)
+	}
+	if ko.Status.StateTransitionedTimestamp == nil || !ko.Status.StateTransitionedTimestamp.Time.Equal(transitioned) {
+		t.Errorf("StateTransitionedTimestamp = %v, want %v", ko.Status.StateTransitionedTimestamp, transitioned)
+	}
+	if ko.Status.EvaluationState != nil {
+		t.Errorf("EvaluationState = %s, want nil", *ko.Status.EvaluationState)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package managed_insight_rule
+
+import (
+	"context"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
+)
+
+// customFindManagedInsightRule reads the managed rules of the resource ARN
+// with ListManagedInsightRules. The operation returns every template that is
+// available for the resource, the rule is only found when the template in the
+// spec has been enabled.
+func (rm *resourceManager) customFindManagedInsightRule(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFindManagedInsightRule")
+	defer func() {
+		exit(err)
+	}()
+
+	// If any required fields in the input shape are missing, AWS resource is
+	// not created yet. Return NotFound here to indicate to callers that the
+	// resource isn't yet created.
+	if r.ko.Spec.ResourceARN == nil || r.ko.Spec.TemplateName == nil {
+		return nil, ackerr.NotFound
+	}
+
+	input := &svcsdk.ListManagedInsightRulesInput{
+		ResourceARN: r.ko.Spec.ResourceARN,
+	}
+	var found *svcsdktypes.ManagedRuleDescription
+	for found == nil {
+		var resp *svcsdk.ListManagedInsightRulesOutput
+		resp, err = rm.sdkapi.ListManagedInsightRules(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "ListManagedInsightRules", err)
+		if err != nil {
+			return nil, err
+		}
+		found = findManagedRule(resp.ManagedRules, *r.ko.Spec.TemplateName)
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	if found == nil {
+		return nil, ackerr.NotFound
+	}
+
+	// Merge in the information we read from the API call above to the copy of
+	// the original Kubernetes object we passed to the function
+	ko := r.ko.DeepCopy()
+
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	ko.Status.RuleName = found.RuleState.RuleName
+	ko.Status.RuleState = found.RuleState.State
+	arn := ackv1alpha1.AWSResourceName(commonutil.InsightRuleARN(
+		rm.awsPartition, rm.awsRegion, rm.awsAccountID, *found.RuleState.RuleName,
+	))
+	ko.Status.ACKResourceMetadata.ARN = &arn
+
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// findManagedRule returns the managed rule created from the template, or nil
+// when the template hasn't been enabled for the resource. Templates that
+// aren't enabled are listed without a RuleState.
+func findManagedRule(
+	rules []svcsdktypes.ManagedRuleDescription,
+	templateName string,
+) *svcsdktypes.ManagedRuleDescription {
+	for i := range rules {
+		if aws.ToString(rules[i].TemplateName) != templateName {
+			continue
+		}
+		if rules[i].RuleState == nil || rules[i].RuleState.RuleName == nil {
+			return nil
+		}
+		return &rules[i]
+	}
+	return nil
+}
+
+// customCreateManagedInsightRule enables the template in the spec for the
+// resource ARN with PutManagedInsightRules. The name of the rule is only
+// returned by ListManagedInsightRules, it is set on the next read.
+func (rm *resourceManager) customCreateManagedInsightRule(
+	ctx context.Context,
+	desired *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customCreateManagedInsightRule")
+	defer func() {
+		exit(err)
+	}()
+
+	rule := svcsdktypes.ManagedRule{
+		ResourceARN:  desired.ko.Spec.ResourceARN,
+		TemplateName: desired.ko.Spec.TemplateName,
+	}
+	for _, tag := range desired.ko.Spec.Tags {
+		rule.Tags = append(rule.Tags, svcsdktypes.Tag{Key: tag.Key, Value: tag.Value})
+	}
+	input := &svcsdk.PutManagedInsightRulesInput{
+		ManagedRules: []svcsdktypes.ManagedRule{rule},
+	}
+
+	var resp *svcsdk.PutManagedInsightRulesOutput
+	resp, err = rm.sdkapi.PutManagedInsightRules(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "PutManagedInsightRules", err)
+	if err != nil {
+		return nil, err
+	}
+	if err = commonutil.InsightRuleFailure(resp.Failures); err != nil {
+		return nil, err
+	}
+
+	ko := desired.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
+
+// customUpdateManagedInsightRule updates the tags of the rule. The other
+// fields of the spec are immutable.
+func (rm *resourceManager) customUpdateManagedInsightRule(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateManagedInsightRule")
+	defer func() {
+		exit(err)
+	}()
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err = commonutil.SyncTags(
+			ctx, rm.sdkapi, rm.metrics, latest.ko.Status.ACKResourceMetadata,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+			rm.cfg.ResourceTagKeys, convertToOrderedACKTags,
+			syncAWSTags, ignoreSystemTags,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	ko := desired.ko.DeepCopy()
+	ko.Status = latest.ko.Status
+	rm.setStatusDefaults(ko)
+	return &resource{ko}, nil
+}
@@ -0,0 +1,57 @@
+package managed_insight_rule
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+func TestFindManagedRule(t *testing.T) {
+	rules := []svcsdktypes.ManagedRuleDescription{
+		{
+			TemplateName: aws.String("DynamoDBContributorInsights-PKC"),
+			RuleState: &svcsdktypes.ManagedRuleState{
+				RuleName: aws.String("DynamoDBContributorInsights-PKC-orders-1700000000000"),
+				State:    aws.String("ENABLED"),
+			},
+		},
+		{
+			TemplateName: aws.String("DynamoDBContributorInsights-PKT"),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		templateName string
+		wantRuleName string
+	}{
+		{
+			name:         "enabled template",
+			templateName: "DynamoDBContributorInsights-PKC",
+			wantRuleName: "DynamoDBContributorInsights-PKC-orders-1700000000000",
+		},
+		{
+			name:         "template not enabled",
+			templateName: "DynamoDBContributorInsights-PKT",
+		},
+		{
+			name:         "unknown template",
+			templateName: "DynamoDBContributorInsights-SKC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findManagedRule(rules, tt.templateName)
+			if tt.wantRuleName == "" {
+				if got != nil {
+					t.Errorf("findManagedRule() = %v, want nil", aws.ToString(got.RuleState.RuleName))
+				}
+				return
+			}
+			if got == nil || aws.ToString(got.RuleState.RuleName) != tt.wantRuleName {
+				t.Errorf("findManagedRule() = %v, want %s", got, tt.wantRuleName)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metric_alarm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerrors "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// maxAlarmActions is the maximum number of actions CloudWatch accepts for
+// each alarm state.
+const maxAlarmActions = 5
+
+var (
+	// ec2AlarmActions are the EC2 instance actions, used in
+	// arn:aws:automate:region:ec2:action ARNs.
+	ec2AlarmActions = map[string]bool{
+		"stop":      true,
+		"terminate": true,
+		"reboot":    true,
+		"recover":   true,
+	}
+	// opsItemCategories are the categories of Systems Manager OpsItems.
+	opsItemCategories = map[string]bool{
+		"Availability": true,
+		"Cost":         true,
+		"Performance":  true,
+		"Recovery":     true,
+		"Security":     true,
+	}
+	// scalingPolicyIDRegex matches the UUID of a scaling policy.
+	scalingPolicyIDRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	// responsePlanNameRegex matches the name of an Incident Manager response
+	// plan.
+	responsePlanNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,200}$`)
+)
+
+// setAlarmActions sets the AlarmActions, OKActions and InsufficientDataActions
+// of the PutMetricAlarm input to the actions of the supplied resource,
+// followed by the ARNs built from its typed actions. Malformed typed actions
+// are returned as a terminal error, so PutMetricAlarm is never called with
+// them. The other actions are left for CloudWatch to validate.
+func (rm *resourceManager) setAlarmActions(
+	input *svcsdk.PutMetricAlarmInput,
+	r *resource,
+) error {
+	alarmActions, err := rm.alarmActionARNs(
+		"alarmActions", r.ko.Spec.AlarmActions,
+		"typedAlarmActions", r.ko.Spec.TypedAlarmActions,
+	)
+	if err != nil {
+		return ackerrors.NewTerminalError(err)
+	}
+	okActions, err := rm.alarmActionARNs(
+		"oKActions", r.ko.Spec.OKActions,
+		"typedOKActions", r.ko.Spec.TypedOKActions,
+	)
+	if err != nil {
+		return ackerrors.NewTerminalError(err)
+	}
+	insufficientDataActions, err := rm.alarmActionARNs(
+		"insufficientDataActions", r.ko.Spec.InsufficientDataActions,
+		"typedInsufficientDataActions", r.ko.Spec.TypedInsufficientDataActions,
+	)
+	if err != nil {
+		return ackerrors.NewTerminalError(err)
+	}
+	input.AlarmActions = alarmActions
+	input.OKActions = okActions
+	input.InsufficientDataActions = insufficientDataActions
+	return nil
+}
+
+// restoreTypedActions replaces the actions read from CloudWatch with the
+// actions of the desired resource when they only differ by the ARNs built
+// from its typed actions, so that the built ARNs don't show up as a
+// difference.
+func (rm *resourceManager) restoreTypedActions(
+	ko *svcapitypes.MetricAlarm,
+	desired *svcapitypes.MetricAlarm,
+) {
+	if rm.builtActionsEqual(ko.Spec.AlarmActions, desired.Spec.AlarmActions, desired.Spec.TypedAlarmActions) {
+		ko.Spec.AlarmActions = desired.Spec.AlarmActions
+	}
+	if rm.builtActionsEqual(ko.Spec.OKActions, desired.Spec.OKActions, desired.Spec.TypedOKActions) {
+		ko.Spec.OKActions = desired.Spec.OKActions
+	}
+	if rm.builtActionsEqual(ko.Spec.InsufficientDataActions, desired.Spec.InsufficientDataActions, desired.Spec.TypedInsufficientDataActions) {
+		ko.Spec.InsufficientDataActions = desired.Spec.InsufficientDataActions
+	}
+}
+
+// builtActionsEqual returns true if the latest actions are the desired
+// actions and the ARNs built from the desired typed actions, in any order.
+func (rm *resourceManager) builtActionsEqual(
+	latest []*string,
+	actions []*string,
+	typed []*svcapitypes.AlarmAction,
+) bool {
+	if len(typed) == 0 {
+		return false
+	}
+	built, err := rm.alarmActionARNs("", actions, "", typed)
+	if err != nil {
+		return false
+	}
+	return stringSetsEqual(aws.StringSlice(built), latest)
+}
+
+// alarmActionARNs returns the supplied actions followed by the ARNs built
+// from the typed actions. The field names are only used in error messages.
+func (rm *resourceManager) alarmActionARNs(
+	actionsField string,
+	actions []*string,
+	typedField string,
+	typed []*svcapitypes.AlarmAction,
+) ([]string, error) {
+	if len(actions) == 0 && len(typed) == 0 {
+		return nil, nil
+	}
+	arns := make([]string, 0, len(actions)+len(typed))
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+		arns = append(arns, *action)
+	}
+	for i, action := range typed {
+		if action == nil {
+			continue
+		}
+		built, err := buildAlarmActionARN(action, rm.awsPartition, rm.awsRegion, rm.awsAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]%s", typedField, i, err)
+		}
+		arns = append(arns, built)
+	}
+	if len(arns) > maxAlarmActions {
+		return nil, fmt.Errorf(
+			"%s and %s have %d actions, at most %d are allowed",
+			actionsField, typedField, len(arns), maxAlarmActions,
+		)
+	}
+	return arns, nil
+}
+
+// buildAlarmActionARN returns the ARN of the supplied typed alarm action in
+// the supplied partition, Region and account. Errors start with the path of
+// the invalid field, relative to the action.
+func buildAlarmActionARN(
+	action *svcapitypes.AlarmAction,
+	partition ackv1alpha1.AWSPartition,
+	region ackv1alpha1.AWSRegion,
+	accountID ackv1alpha1.AWSAccountID,
+) (string, error) {
+	set := 0
+	for _, isSet := range []bool{
+		action.AutoScaling != nil,
+		action.EC2 != nil,
+		action.OpsItem != nil,
+		action.ResponsePlan != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return "", fmt.Errorf(": exactly one of autoScaling, ec2, opsItem or responsePlan must be set")
+	}
+
+	switch {
+	case action.AutoScaling != nil:
+		return buildAutoScalingActionARN(action.AutoScaling, partition, region, accountID)
+	case action.EC2 != nil:
+		ec2Action := aws.ToString(action.EC2.Action)
+		if !ec2AlarmActions[ec2Action] {
+			return "", fmt.Errorf(".ec2.action: %q is not one of stop, terminate, reboot or recover", ec2Action)
+		}
+		return fmt.Sprintf("arn:%s:automate:%s:ec2:%s", partition, region, ec2Action), nil
+	case action.OpsItem != nil:
+		severity := aws.ToInt64(action.OpsItem.Severity)
+		if severity < 1 || severity > 4 {
+			return "", fmt.Errorf(".opsItem.severity: %d is not between 1 and 4", severity)
+		}
+		opsItemARN := fmt.Sprintf("arn:%s:ssm:%s:%s:opsitem:%d", partition, region, accountID, severity)
+		if category := action.OpsItem.Category; category != nil {
+			if !opsItemCategories[*category] {
+				return "", fmt.Errorf(
+					".opsItem.category: %q is not one of Availability, Cost, Performance, Recovery or Security",
+					*category,
+				)
+			}
+			opsItemARN += "#CATEGORY=" + *category
+		}
+		return opsItemARN, nil
+	default:
+		name := aws.ToString(action.ResponsePlan.Name)
+		if !responsePlanNameRegex.MatchString(name) {
+			return "", fmt.Errorf(".responsePlan.name: %q is not a valid response plan name", name)
+		}
+		// Incident Manager response plans are global, their ARNs have no
+		// Region.
+		return fmt.Sprintf("arn:%s:ssm-incidents::%s:responseplan/%s", partition, accountID, name), nil
+	}
+}
+
+// buildAutoScalingActionARN returns the ARN of an EC2 Auto Scaling or
+// Application Auto Scaling scaling policy.
+func buildAutoScalingActionARN(
+	action *svcapitypes.AutoScalingAlarmAction,
+	partition ackv1alpha1.AWSPartition,
+	region ackv1alpha1.AWSRegion,
+	accountID ackv1alpha1.AWSAccountID,
+) (string, error) {
+	policyID := aws.ToString(action.PolicyID)
+	if !scalingPolicyIDRegex.MatchString(policyID) {
+		return "", fmt.Errorf(".autoScaling.policyID: %q is not a scaling policy ID", policyID)
+	}
+	policyName := aws.ToString(action.PolicyName)
+	if policyName == "" || strings.Contains(policyName, ":") {
+		return "", fmt.Errorf(".autoScaling.policyName: %q is not a valid policy name", policyName)
+	}
+
+	var resource string
+	switch {
+	case action.AutoScalingGroupName != nil:
+		if action.ServiceNamespace != nil || action.ResourceID != nil {
+			return "", fmt.Errorf(
+				".autoScaling: autoScalingGroupName can't be set with serviceNamespace and resourceID",
+			)
+		}
+		if *action.AutoScalingGroupName == "" {
+			return "", fmt.Errorf(".autoScaling.autoScalingGroupName: must not be empty")
+		}
+		resource = "autoScalingGroupName/" + *action.AutoScalingGroupName
+	case action.ServiceNamespace != nil && action.ResourceID != nil:
+		if *action.ServiceNamespace == "" || *action.ResourceID == "" {
+			return "", fmt.Errorf(".autoScaling: serviceNamespace and resourceID must not be empty")
+		}
+		resource = "resource/" + *action.ServiceNamespace + "/" + *action.ResourceID
+	default:
+		return "", fmt.Errorf(
+			".autoScaling: either autoScalingGroupName, or serviceNamespace and resourceID must be set",
+		)
+	}
+	return fmt.Sprintf(
+		"arn:%s:autoscaling:%s:%s:scalingPolicy:%s:%s:policyName/%s",
+		partition, region, accountID, policyID, resource, policyName,
+	), nil
+}
@@ -0,0 +1,258 @@
+package metric_alarm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+const testPolicyID = "4e5bd4f5-7b8e-4d07-9e1a-0a2b3c4d5e6f"
+
+func TestBuildAlarmActionARN(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  *svcapitypes.AlarmAction
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "ec2 stop",
+			action: &svcapitypes.AlarmAction{EC2: &svcapitypes.EC2AlarmAction{Action: aws.String("stop")}},
+			want:   "arn:aws:automate:us-west-2:ec2:stop",
+		},
+		{
+			name:    "ec2 unknown action",
+			action:  &svcapitypes.AlarmAction{EC2: &svcapitypes.EC2AlarmAction{Action: aws.String("hibernate")}},
+			wantErr: ".ec2.action",
+		},
+		{
+			name: "ec2 auto scaling group policy",
+			action: &svcapitypes.AlarmAction{AutoScaling: &svcapitypes.AutoScalingAlarmAction{
+				AutoScalingGroupName: aws.String("web"),
+				PolicyID:             aws.String(testPolicyID),
+				PolicyName:           aws.String("scale-out"),
+			}},
+			want: "arn:aws:autoscaling:us-west-2:111122223333:scalingPolicy:" + testPolicyID +
+				":autoScalingGroupName/web:policyName/scale-out",
+		},
+		{
+			name: "application auto scaling policy",
+			action: &svcapitypes.AlarmAction{AutoScaling: &svcapitypes.AutoScalingAlarmAction{
+				ServiceNamespace: aws.String("ecs"),
+				ResourceID:       aws.String("service/default/web"),
+				PolicyID:         aws.String(testPolicyID),
+				PolicyName:       aws.String("cpu"),
+			}},
+			want: "arn:aws:autoscaling:us-west-2:111122223333:scalingPolicy:" + testPolicyID +
+				":resource/ecs/service/default/web:policyName/cpu",
+		},
+		{
+			name: "auto scaling policy without target",
+			action: &svcapitypes.AlarmAction{AutoScaling: &svcapitypes.AutoScalingAlarmAction{
+				PolicyID:   aws.String(testPolicyID),
+				PolicyName: aws.String("cpu"),
+			}},
+			wantErr: ".autoScaling:",
+		},
+		{
+			name: "auto scaling policy with both targets",
+			action: &svcapitypes.AlarmAction{AutoScaling: &svcapitypes.AutoScalingAlarmAction{
+				AutoScalingGroupName: aws.String("web"),
+				ServiceNamespace:     aws.String("ecs"),
+				ResourceID:           aws.String("service/default/web"),
+				PolicyID:             aws.String(testPolicyID),
+				PolicyName:           aws.String("cpu"),
+			}},
+			wantErr: ".autoScaling:",
+		},
+		{
+			name: "auto scaling policy with malformed id",
+			action: &svcapitypes.AlarmAction{AutoScaling: &svcapitypes.AutoScalingAlarmAction{
+				AutoScalingGroupName: aws.String("web"),
+				PolicyID:             aws.String("scale-out"),
+				PolicyName:           aws.String("scale-out"),
+			}},
+			wantErr: ".autoScaling.policyID",
+		},
+		{
+			name:   "opsitem",
+			action: &svcapitypes.AlarmAction{OpsItem: &svcapitypes.OpsItemAlarmAction{Severity: aws.Int64(2)}},
+			want:   "arn:aws:ssm:us-west-2:111122223333:opsitem:2",
+		},
+		{
+			name: "opsitem with category",
+			action: &svcapitypes.AlarmAction{OpsItem: &svcapitypes.OpsItemAlarmAction{
+				Severity: aws.Int64(1),
+				Category: aws.String("Availability"),
+			}},
+			want: "arn:aws:ssm:us-west-2:111122223333:opsitem:1#CATEGORY=Availability",
+		},
+		{
+			name:    "opsitem severity out of range",
+			action:  &svcapitypes.AlarmAction{OpsItem: &svcapitypes.OpsItemAlarmAction{Severity: aws.Int64(5)}},
+			wantErr: ".opsItem.severity",
+		},
+		{
+			name: "opsitem unknown category",
+			action: &svcapitypes.AlarmAction{OpsItem: &svcapitypes.OpsItemAlarmAction{
+				Severity: aws.Int64(3),
+				Category: aws.String("Outage"),
+			}},
+			wantErr: ".opsItem.category",
+		},
+		{
+			name:   "response plan",
+			action: &svcapitypes.AlarmAction{ResponsePlan: &svcapitypes.ResponsePlanAlarmAction{Name: aws.String("on-call")}},
+			want:   "arn:aws:ssm-incidents::111122223333:responseplan/on-call",
+		},
+		{
+			name:    "response plan with invalid name",
+			action:  &svcapitypes.AlarmAction{ResponsePlan: &svcapitypes.ResponsePlanAlarmAction{Name: aws.String("on call")}},
+			wantErr: ".responsePlan.name",
+		},
+		{
+			name:    "no action",
+			action:  &svcapitypes.AlarmAction{},
+			wantErr: "exactly one",
+		},
+		{
+			name: "two actions",
+			action: &svcapitypes.AlarmAction{
+				EC2:     &svcapitypes.EC2AlarmAction{Action: aws.String("reboot")},
+				OpsItem: &svcapitypes.OpsItemAlarmAction{Severity: aws.Int64(2)},
+			},
+			wantErr: "exactly one",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildAlarmActionARN(tt.action, "aws", "us-west-2", "111122223333")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("buildAlarmActionARN() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildAlarmActionARN() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("buildAlarmActionARN() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetAlarmActions(t *testing.T) {
+	const topicARN = "arn:aws:sns:us-west-2:111122223333:pager"
+	reboot := &svcapitypes.AlarmAction{EC2: &svcapitypes.EC2AlarmAction{Action: aws.String("reboot")}}
+
+	tests := []struct {
+		name             string
+		spec             svcapitypes.MetricAlarmSpec
+		wantAlarmActions []string
+		wantOKActions    []string
+		wantErr          string
+	}{
+		{
+			name: "no actions",
+		},
+		{
+			name: "actions followed by typed actions",
+			spec: svcapitypes.MetricAlarmSpec{
+				AlarmActions:      aws.StringSlice([]string{topicARN}),
+				TypedAlarmActions: []*svcapitypes.AlarmAction{reboot},
+				OKActions:         aws.StringSlice([]string{topicARN}),
+			},
+			wantAlarmActions: []string{topicARN, "arn:aws:automate:us-west-2:ec2:reboot"},
+			wantOKActions:    []string{topicARN},
+		},
+		{
+			name: "malformed action left to CloudWatch",
+			spec: svcapitypes.MetricAlarmSpec{
+				OKActions: aws.StringSlice([]string{"sns:pager"}),
+			},
+			wantOKActions: []string{"sns:pager"},
+		},
+		{
+			name: "malformed typed action",
+			spec: svcapitypes.MetricAlarmSpec{
+				TypedInsufficientDataActions: []*svcapitypes.AlarmAction{{}},
+			},
+			wantErr: "typedInsufficientDataActions[0]",
+		},
+		{
+			name: "too many actions",
+			spec: svcapitypes.MetricAlarmSpec{
+				AlarmActions:      aws.StringSlice([]string{topicARN, topicARN, topicARN, topicARN}),
+				TypedAlarmActions: []*svcapitypes.AlarmAction{reboot, reboot},
+			},
+			wantErr: "at most 5",
+		},
+	}
+
+	rm := &resourceManager{
+		awsPartition: "aws",
+		awsRegion:    ackv1alpha1.AWSRegion("us-west-2"),
+		awsAccountID: ackv1alpha1.AWSAccountID("111122223333"),
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &svcsdk.PutMetricAlarmInput{}
+			err := rm.setAlarmActions(input, &resource{&svcapitypes.MetricAlarm{Spec: tt.spec}})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("setAlarmActions() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("setAlarmActions() error = %v", err)
+			}
+			if !reflect.DeepEqual(input.AlarmActions, tt.wantAlarmActions) {
+				t.Errorf("AlarmActions = %v, want %v", input.AlarmActions, tt.wantAlarmActions)
+			}
+			if !reflect.DeepEqual(input.OKActions, tt.wantOKActions) {
+				t.Errorf("OKActions = %v, want %v", input.OKActions, tt.wantOKActions)
+			}
+		})
+	}
+}
+
+func TestRestoreTypedActions(t *testing.T) {
+	const topicARN = "arn:aws:sns:us-west-2:111122223333:pager"
+	rm := &resourceManager{
+		awsPartition: "aws",
+		awsRegion:    ackv1alpha1.AWSRegion("us-west-2"),
+		awsAccountID: ackv1alpha1.AWSAccountID("111122223333"),
+	}
+	desired := &svcapitypes.MetricAlarm{Spec: svcapitypes.MetricAlarmSpec{
+		AlarmActions: aws.StringSlice([]string{topicARN}),
+		TypedAlarmActions: []*svcapitypes.AlarmAction{
+			{EC2: &svcapitypes.EC2AlarmAction{Action: aws.String("recover")}},
+		},
+		TypedOKActions: []*svcapitypes.AlarmAction{
+			{OpsItem: &svcapitypes.OpsItemAlarmAction{Severity: aws.Int64(3)}},
+		},
+	}}
+
+	ko := desired.DeepCopy()
+	ko.Spec.AlarmActions = aws.StringSlice([]string{topicARN, "arn:aws:automate:us-west-2:ec2:recover"})
+	// The OpsItem severity was changed outside of the controller.
+	ko.Spec.OKActions = aws.StringSlice([]string{"arn:aws:ssm:us-west-2:111122223333:opsitem:1"})
+	rm.restoreTypedActions(ko, desired)
+
+	if got := aws.ToStringSlice(ko.Spec.AlarmActions); !reflect.DeepEqual(got, []string{topicARN}) {
+		t.Errorf("AlarmActions = %v, want the desired actions", got)
+	}
+	if got := aws.ToStringSlice(ko.Spec.OKActions); len(got) != 1 || got[0] != "arn:aws:ssm:us-west-2:111122223333:opsitem:1" {
+		t.Errorf("OKActions = %v, want the actions read from CloudWatch", got)
+	}
+}
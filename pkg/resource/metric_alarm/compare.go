@@ -0,0 +1,176 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metric_alarm
+
+import (
+	"slices"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// compareUnorderedFields adds the actions, dimensions and metrics of the
+// alarm to the delta when they differ regardless of their order.
+// CloudWatch returns them in its own order, so comparing them as ordered
+// slices would update the alarm on every reconciliation.
+func compareUnorderedFields(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if !stringSetsEqual(a.ko.Spec.AlarmActions, b.ko.Spec.AlarmActions) {
+		delta.Add("Spec.AlarmActions", a.ko.Spec.AlarmActions, b.ko.Spec.AlarmActions)
+	}
+	if !stringSetsEqual(a.ko.Spec.OKActions, b.ko.Spec.OKActions) {
+		delta.Add("Spec.OKActions", a.ko.Spec.OKActions, b.ko.Spec.OKActions)
+	}
+	if !stringSetsEqual(a.ko.Spec.InsufficientDataActions, b.ko.Spec.InsufficientDataActions) {
+		delta.Add("Spec.InsufficientDataActions", a.ko.Spec.InsufficientDataActions, b.ko.Spec.InsufficientDataActions)
+	}
+	if !dimensionSetsEqual(a.ko.Spec.Dimensions, b.ko.Spec.Dimensions) {
+		delta.Add("Spec.Dimensions", a.ko.Spec.Dimensions, b.ko.Spec.Dimensions)
+	}
+	if !metricsEqual(a.ko.Spec.Metrics, b.ko.Spec.Metrics) {
+		delta.Add("Spec.Metrics", a.ko.Spec.Metrics, b.ko.Spec.Metrics)
+	}
+}
+
+// stringSetsEqual returns true if the supplied slices hold the same strings,
+// regardless of their order and of duplicates.
+func stringSetsEqual(a, b []*string) bool {
+	return setsEqual(aws.ToStringSlice(a), aws.ToStringSlice(b))
+}
+
+// dimensionSetsEqual returns true if the supplied slices hold the same
+// dimensions, regardless of their order and of duplicates.
+func dimensionSetsEqual(a, b []*svcapitypes.Dimension) bool {
+	keys := func(dimensions []*svcapitypes.Dimension) []string {
+		s := make([]string, len(dimensions))
+		for i, d := range dimensions {
+			s[i] = dimensionKey(d)
+		}
+		return s
+	}
+	return setsEqual(keys(a), keys(b))
+}
+
+// metricsEqual returns true if the supplied metric data queries are equal
+// when matched by ID rather than by position. The dimensions of the queried
+// metrics are compared regardless of their order.
+func metricsEqual(a, b []*svcapitypes.MetricDataQuery) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byID := make(map[string]*svcapitypes.MetricDataQuery, len(b))
+	for _, q := range b {
+		byID[metricID(q)] = q
+	}
+	for _, q := range a {
+		id := metricID(q)
+		other, ok := byID[id]
+		if !ok {
+			return false
+		}
+		// Every query of b is matched once, so that a duplicate ID in a
+		// doesn't hide a query of b.
+		delete(byID, id)
+		if !equality.Semantic.DeepEqual(sortedMetric(q), sortedMetric(other)) {
+			return false
+		}
+	}
+	return true
+}
+
+// sortAlarmLists sorts the actions, dimensions and metrics read from
+// CloudWatch, so that successive reads of an alarm are equal whatever order
+// CloudWatch returns them in. Actions are sorted by ARN, dimensions by name
+// and value, and metrics by ID.
+func sortAlarmLists(ko *svcapitypes.MetricAlarm) {
+	sortStrings(ko.Spec.AlarmActions)
+	sortStrings(ko.Spec.OKActions)
+	sortStrings(ko.Spec.InsufficientDataActions)
+	sortDimensions(ko.Spec.Dimensions)
+	slices.SortStableFunc(ko.Spec.Metrics, func(a, b *svcapitypes.MetricDataQuery) int {
+		return strings.Compare(metricID(a), metricID(b))
+	})
+	for _, q := range ko.Spec.Metrics {
+		if q != nil && q.MetricStat != nil && q.MetricStat.Metric != nil {
+			sortDimensions(q.MetricStat.Metric.Dimensions)
+		}
+	}
+}
+
+// sortedMetric returns a copy of the supplied query with the dimensions of
+// its metric sorted.
+func sortedMetric(q *svcapitypes.MetricDataQuery) *svcapitypes.MetricDataQuery {
+	if q == nil || q.MetricStat == nil || q.MetricStat.Metric == nil {
+		return q
+	}
+	q = q.DeepCopy()
+	sortDimensions(q.MetricStat.Metric.Dimensions)
+	return q
+}
+
+func sortStrings(s []*string) {
+	slices.SortStableFunc(s, func(a, b *string) int {
+		return strings.Compare(aws.ToString(a), aws.ToString(b))
+	})
+}
+
+func sortDimensions(dimensions []*svcapitypes.Dimension) {
+	slices.SortStableFunc(dimensions, func(a, b *svcapitypes.Dimension) int {
+		return strings.Compare(dimensionKey(a), dimensionKey(b))
+	})
+}
+
+// setsEqual returns true if the supplied slices hold the same values,
+// regardless of their order and of duplicates.
+func setsEqual(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[v] = false
+	}
+	for _, v := range b {
+		if _, ok := set[v]; !ok {
+			return false
+		}
+		set[v] = true
+	}
+	for _, seen := range set {
+		if !seen {
+			return false
+		}
+	}
+	return true
+}
+
+// dimensionKey returns a key identifying the name and value of the supplied
+// dimension. Dimension names and values can't contain a NUL character.
+func dimensionKey(d *svcapitypes.Dimension) string {
+	if d == nil {
+		return ""
+	}
+	return aws.ToString(d.Name) + "\x00" + aws.ToString(d.Value)
+}
+
+func metricID(q *svcapitypes.MetricDataQuery) string {
+	if q == nil {
+		return ""
+	}
+	return aws.ToString(q.ID)
+}
@@ -0,0 +1,180 @@
+package metric_alarm
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func dimension(name, value string) *svcapitypes.Dimension {
+	return &svcapitypes.Dimension{Name: aws.String(name), Value: aws.String(value)}
+}
+
+func metricQuery(id string, dimensions ...*svcapitypes.Dimension) *svcapitypes.MetricDataQuery {
+	return &svcapitypes.MetricDataQuery{
+		ID: aws.String(id),
+		MetricStat: &svcapitypes.MetricStat{
+			Metric: &svcapitypes.Metric{
+				Dimensions: dimensions,
+				MetricName: aws.String("CPUUtilization"),
+				Namespace:  aws.String("AWS/EC2"),
+			},
+			Period: aws.Int64(60),
+			Stat:   aws.String("Average"),
+		},
+	}
+}
+
+func TestCompareUnorderedFields(t *testing.T) {
+	alarm := func(spec svcapitypes.MetricAlarmSpec) *resource {
+		return &resource{&svcapitypes.MetricAlarm{Spec: spec}}
+	}
+	tests := []struct {
+		name     string
+		desired  svcapitypes.MetricAlarmSpec
+		latest   svcapitypes.MetricAlarmSpec
+		wantDiff []string
+	}{
+		{
+			name: "reordered",
+			desired: svcapitypes.MetricAlarmSpec{
+				AlarmActions:            aws.StringSlice([]string{"arn:b", "arn:a"}),
+				OKActions:               aws.StringSlice([]string{"arn:c", "arn:a"}),
+				InsufficientDataActions: aws.StringSlice([]string{"arn:a", "arn:b", "arn:c"}),
+				Dimensions:              []*svcapitypes.Dimension{dimension("B", "2"), dimension("A", "1")},
+				Metrics: []*svcapitypes.MetricDataQuery{
+					{ID: aws.String("e1"), Expression: aws.String("m1 * 2")},
+					metricQuery("m1", dimension("B", "2"), dimension("A", "1")),
+				},
+			},
+			latest: svcapitypes.MetricAlarmSpec{
+				AlarmActions:            aws.StringSlice([]string{"arn:a", "arn:b"}),
+				OKActions:               aws.StringSlice([]string{"arn:a", "arn:c"}),
+				InsufficientDataActions: aws.StringSlice([]string{"arn:c", "arn:b", "arn:a"}),
+				Dimensions:              []*svcapitypes.Dimension{dimension("A", "1"), dimension("B", "2")},
+				Metrics: []*svcapitypes.MetricDataQuery{
+					metricQuery("m1", dimension("A", "1"), dimension("B", "2")),
+					{ID: aws.String("e1"), Expression: aws.String("m1 * 2")},
+				},
+			},
+		},
+		{
+			name: "duplicate action",
+			desired: svcapitypes.MetricAlarmSpec{
+				AlarmActions: aws.StringSlice([]string{"arn:a", "arn:a"}),
+			},
+			latest: svcapitypes.MetricAlarmSpec{
+				AlarmActions: aws.StringSlice([]string{"arn:a"}),
+			},
+		},
+		{
+			name: "empty and nil",
+			desired: svcapitypes.MetricAlarmSpec{
+				OKActions:  []*string{},
+				Dimensions: []*svcapitypes.Dimension{},
+			},
+		},
+		{
+			name: "different",
+			desired: svcapitypes.MetricAlarmSpec{
+				AlarmActions:            aws.StringSlice([]string{"arn:a", "arn:b"}),
+				OKActions:               aws.StringSlice([]string{"arn:a"}),
+				InsufficientDataActions: aws.StringSlice([]string{"arn:a"}),
+				Dimensions:              []*svcapitypes.Dimension{dimension("A", "1")},
+			},
+			latest: svcapitypes.MetricAlarmSpec{
+				AlarmActions: aws.StringSlice([]string{"arn:a"}),
+				OKActions:    aws.StringSlice([]string{"arn:b"}),
+				Dimensions:   []*svcapitypes.Dimension{dimension("A", "2")},
+			},
+			wantDiff: []string{"Spec.AlarmActions", "Spec.OKActions", "Spec.InsufficientDataActions", "Spec.Dimensions"},
+		},
+		{
+			name: "metric changed",
+			desired: svcapitypes.MetricAlarmSpec{
+				Metrics: []*svcapitypes.MetricDataQuery{
+					metricQuery("m1", dimension("A", "1")),
+					{ID: aws.String("e1"), Expression: aws.String("m1 * 2")},
+				},
+			},
+			latest: svcapitypes.MetricAlarmSpec{
+				Metrics: []*svcapitypes.MetricDataQuery{
+					{ID: aws.String("e1"), Expression: aws.String("m1 * 3")},
+					metricQuery("m1", dimension("A", "1")),
+				},
+			},
+			wantDiff: []string{"Spec.Metrics"},
+		},
+		{
+			name: "metric ids swapped",
+			desired: svcapitypes.MetricAlarmSpec{
+				Metrics: []*svcapitypes.MetricDataQuery{
+					{ID: aws.String("m1"), Expression: aws.String("e1 * 2")},
+					metricQuery("e1"),
+				},
+			},
+			latest: svcapitypes.MetricAlarmSpec{
+				Metrics: []*svcapitypes.MetricDataQuery{
+					{ID: aws.String("e1"), Expression: aws.String("e1 * 2")},
+					metricQuery("m1"),
+				},
+			},
+			wantDiff: []string{"Spec.Metrics"},
+		},
+		{
+			name: "duplicate metric id",
+			desired: svcapitypes.MetricAlarmSpec{
+				Metrics: []*svcapitypes.MetricDataQuery{metricQuery("m1"), metricQuery("m1")},
+			},
+			latest: svcapitypes.MetricAlarmSpec{
+				Metrics: []*svcapitypes.MetricDataQuery{metricQuery("m1"), metricQuery("m2")},
+			},
+			wantDiff: []string{"Spec.Metrics"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := newResourceDelta(alarm(tt.desired), alarm(tt.latest))
+			if got := len(delta.Differences); got != len(tt.wantDiff) {
+				t.Fatalf("got %d differences, want %v", got, tt.wantDiff)
+			}
+			for _, path := range tt.wantDiff {
+				if !delta.DifferentAt(path) {
+					t.Errorf("no difference at %s", path)
+				}
+			}
+		})
+	}
+}
+
+func TestSortAlarmLists(t *testing.T) {
+	ko := &svcapitypes.MetricAlarm{Spec: svcapitypes.MetricAlarmSpec{
+		AlarmActions: aws.StringSlice([]string{"arn:b", "arn:a"}),
+		Dimensions:   []*svcapitypes.Dimension{dimension("B", "1"), dimension("A", "2"), dimension("A", "1")},
+		Metrics: []*svcapitypes.MetricDataQuery{
+			metricQuery("m2"),
+			metricQuery("m1", dimension("B", "2"), dimension("A", "1")),
+		},
+	}}
+	sortAlarmLists(ko)
+
+	if got := aws.ToStringSlice(ko.Spec.AlarmActions); got[0] != "arn:a" || got[1] != "arn:b" {
+		t.Errorf("alarm actions not sorted: %v", got)
+	}
+	var dimensions []string
+	for _, d := range ko.Spec.Dimensions {
+		dimensions = append(dimensions, *d.Name+"="+*d.Value)
+	}
+	if got, want := dimensions, []string{"A=1", "A=2", "B=1"}; !slices.Equal(got, want) {
+		t.Errorf("dimensions = %v, want %v", got, want)
+	}
+	if got := *ko.Spec.Metrics[0].ID; got != "m1" {
+		t.Errorf("first metric = %s, want m1", got)
+	}
+	if got := *ko.Spec.Metrics[0].MetricStat.Metric.Dimensions[0].Name; got != "A" {
+		t.Errorf("first metric dimension = %s, want A", got)
+	}
+}
@@ -41,6 +41,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	compareUnorderedFields(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.ActionsEnabled, b.ko.Spec.ActionsEnabled) {
 		delta.Add("Spec.ActionsEnabled", a.ko.Spec.ActionsEnabled, b.ko.Spec.ActionsEnabled)
@@ -49,12 +50,8 @@ func newResourceDelta(
 			delta.Add("Spec.ActionsEnabled", a.ko.Spec.ActionsEnabled, b.ko.Spec.ActionsEnabled)
 		}
 	}
-	if len(a.ko.Spec.AlarmActions) != len(b.ko.Spec.AlarmActions) {
-		delta.Add("Spec.AlarmActions", a.ko.Spec.AlarmActions, b.ko.Spec.AlarmActions)
-	} else if len(a.ko.Spec.AlarmActions) > 0 {
-		if !ackcompare.SliceStringPEqual(a.ko.Spec.AlarmActions, b.ko.Spec.AlarmActions) {
-			delta.Add("Spec.AlarmActions", a.ko.Spec.AlarmActions, b.ko.Spec.AlarmActions)
-		}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.AlarmActionRefs, b.ko.Spec.AlarmActionRefs) {
+		delta.Add("Spec.AlarmActionRefs", a.ko.Spec.AlarmActionRefs, b.ko.Spec.AlarmActionRefs)
 	}
 	if ackcompare.HasNilDifference(a.ko.Spec.AlarmDescription, b.ko.Spec.AlarmDescription) {
 		delta.Add("Spec.AlarmDescription", a.ko.Spec.AlarmDescription, b.ko.Spec.AlarmDescription)
@@ -77,13 +74,6 @@ func newResourceDelta(
 			delta.Add("Spec.DatapointsToAlarm", a.ko.Spec.DatapointsToAlarm, b.ko.Spec.DatapointsToAlarm)
 		}
 	}
-	if len(a.ko.Spec.Dimensions) != len(b.ko.Spec.Dimensions) {
-		delta.Add("Spec.Dimensions", a.ko.Spec.Dimensions, b.ko.Spec.Dimensions)
-	} else if len(a.ko.Spec.Dimensions) > 0 {
-		if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.Dimensions, b.ko.Spec.Dimensions) {
-			delta.Add("Spec.Dimensions", a.ko.Spec.Dimensions, b.ko.Spec.Dimensions)
-		}
-	}
 	if ackcompare.HasNilDifference(a.ko.Spec.EvaluateLowSampleCountPercentile, b.ko.Spec.EvaluateLowSampleCountPercentile) {
 		delta.Add("Spec.EvaluateLowSampleCountPercentile", a.ko.Spec.EvaluateLowSampleCountPercentile, b.ko.Spec.EvaluateLowSampleCountPercentile)
 	} else if a.ko.Spec.EvaluateLowSampleCountPercentile != nil && b.ko.Spec.EvaluateLowSampleCountPercentile != nil {
@@ -160,12 +150,8 @@ func newResourceDelta(
 			delta.Add("Spec.ExtendedStatistic", a.ko.Spec.ExtendedStatistic, b.ko.Spec.ExtendedStatistic)
 		}
 	}
-	if len(a.ko.Spec.InsufficientDataActions) != len(b.ko.Spec.InsufficientDataActions) {
-		delta.Add("Spec.InsufficientDataActions", a.ko.Spec.InsufficientDataActions, b.ko.Spec.InsufficientDataActions)
-	} else if len(a.ko.Spec.InsufficientDataActions) > 0 {
-		if !ackcompare.SliceStringPEqual(a.ko.Spec.InsufficientDataActions, b.ko.Spec.InsufficientDataActions) {
-			delta.Add("Spec.InsufficientDataActions", a.ko.Spec.InsufficientDataActions, b.ko.Spec.InsufficientDataActions)
-		}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.InsufficientDataActionRefs, b.ko.Spec.InsufficientDataActionRefs) {
+		delta.Add("Spec.InsufficientDataActionRefs", a.ko.Spec.InsufficientDataActionRefs, b.ko.Spec.InsufficientDataActionRefs)
 	}
 	if ackcompare.HasNilDifference(a.ko.Spec.MetricName, b.ko.Spec.MetricName) {
 		delta.Add("Spec.MetricName", a.ko.Spec.MetricName, b.ko.Spec.MetricName)
@@ -174,13 +160,6 @@ func newResourceDelta(
 			delta.Add("Spec.MetricName", a.ko.Spec.MetricName, b.ko.Spec.MetricName)
 		}
 	}
-	if len(a.ko.Spec.Metrics) != len(b.ko.Spec.Metrics) {
-		delta.Add("Spec.Metrics", a.ko.Spec.Metrics, b.ko.Spec.Metrics)
-	} else if len(a.ko.Spec.Metrics) > 0 {
-		if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.Metrics, b.ko.Spec.Metrics) {
-			delta.Add("Spec.Metrics", a.ko.Spec.Metrics, b.ko.Spec.Metrics)
-		}
-	}
 	if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name) {
 		delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	} else if a.ko.Spec.Name != nil && b.ko.Spec.Name != nil {
@@ -195,12 +174,8 @@ func newResourceDelta(
 			delta.Add("Spec.Namespace", a.ko.Spec.Namespace, b.ko.Spec.Namespace)
 		}
 	}
-	if len(a.ko.Spec.OKActions) != len(b.ko.Spec.OKActions) {
-		delta.Add("Spec.OKActions", a.ko.Spec.OKActions, b.ko.Spec.OKActions)
-	} else if len(a.ko.Spec.OKActions) > 0 {
-		if !ackcompare.SliceStringPEqual(a.ko.Spec.OKActions, b.ko.Spec.OKActions) {
-			delta.Add("Spec.OKActions", a.ko.Spec.OKActions, b.ko.Spec.OKActions)
-		}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.OKActionRefs, b.ko.Spec.OKActionRefs) {
+		delta.Add("Spec.OKActionRefs", a.ko.Spec.OKActionRefs, b.ko.Spec.OKActionRefs)
 	}
 	if ackcompare.HasNilDifference(a.ko.Spec.Period, b.ko.Spec.Period) {
 		delta.Add("Spec.Period", a.ko.Spec.Period, b.ko.Spec.Period)
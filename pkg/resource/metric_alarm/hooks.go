@@ -0,0 +1,186 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metric_alarm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerrors "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
+)
+
+// ConditionTypeAlarmFiring is True while the alarm is in the ALARM state and
+// False while it is OK or INSUFFICIENT_DATA. The condition reason carries the
+// alarm state and the message carries CloudWatch's StateReason.
+const ConditionTypeAlarmFiring ackv1alpha1.ConditionType = "CloudWatch.AlarmFiring"
+
+// updateCustomConditions sets the AlarmFiring condition and the reason of
+// the Recoverable condition when a CloudWatch quota is reached. It returns
+// true if the conditions of the resource were changed.
+func (rm *resourceManager) updateCustomConditions(
+	ko *svcapitypes.MetricAlarm,
+	r *resource,
+	err error,
+) bool {
+	quotaUpdated := commonutil.SetQuotaExceededReason(ko.Status.Conditions, err)
+	stateUpdated := rm.updateAlarmStateCondition(ko)
+	return quotaUpdated || stateUpdated
+}
+
+// updateAlarmStateCondition sets the AlarmFiring condition from the alarm
+// state last read from CloudWatch. It returns true if the conditions of the
+// resource were changed.
+func (rm *resourceManager) updateAlarmStateCondition(ko *svcapitypes.MetricAlarm) bool {
+	if ko.Status.StateValue == nil {
+		return false
+	}
+
+	var firingCondition *ackv1alpha1.Condition
+	for _, condition := range ko.Status.Conditions {
+		if condition.Type == ConditionTypeAlarmFiring {
+			firingCondition = condition
+			break
+		}
+	}
+	updated := false
+	if firingCondition == nil {
+		firingCondition = &ackv1alpha1.Condition{
+			Type: ConditionTypeAlarmFiring,
+		}
+		ko.Status.Conditions = append(ko.Status.Conditions, firingCondition)
+		updated = true
+	}
+
+	status := corev1.ConditionFalse
+	if *ko.Status.StateValue == string(svcsdktypes.StateValueAlarm) {
+		status = corev1.ConditionTrue
+	}
+	if firingCondition.Status != status {
+		firingCondition.Status = status
+		transitionTime := metav1.Now()
+		if ko.Status.StateTransitionedTimestamp != nil {
+			transitionTime = *ko.Status.StateTransitionedTimestamp
+		}
+		firingCondition.LastTransitionTime = &transitionTime
+		updated = true
+	}
+	if !equalStrings(firingCondition.Reason, ko.Status.StateValue) {
+		firingCondition.Reason = ko.Status.StateValue
+		updated = true
+	}
+	if !equalStrings(firingCondition.Message, ko.Status.StateReason) {
+		firingCondition.Message = ko.Status.StateReason
+		updated = true
+	}
+	return updated
+}
+
+// equalStrings returns true if the supplied strings are both nil or have the
+// same value.
+func equalStrings(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// alarmARNResourcePrefix is the prefix of the resource part of a CloudWatch
+// alarm ARN, e.g. arn:aws:cloudwatch:us-west-2:123456789012:alarm:my-alarm
+const alarmARNResourcePrefix = "alarm:"
+
+// setIdentifiersFromARN sets the alarm name from the supplied alarm ARN, so
+// that alarms can be adopted by ARN as well as by name.
+func (r *resource) setIdentifiersFromARN(alarmARN string) error {
+	parsed, err := arn.Parse(alarmARN)
+	if err != nil {
+		return ackerrors.NewTerminalError(fmt.Errorf("invalid alarm ARN %q: %v", alarmARN, err))
+	}
+	if parsed.Service != "cloudwatch" ||
+		!strings.HasPrefix(parsed.Resource, alarmARNResourcePrefix) ||
+		len(parsed.Resource) == len(alarmARNResourcePrefix) {
+		return ackerrors.NewTerminalError(fmt.Errorf("%q is not a CloudWatch alarm ARN", alarmARN))
+	}
+	name := strings.TrimPrefix(parsed.Resource, alarmARNResourcePrefix)
+	r.ko.Spec.Name = &name
+
+	if r.ko.Status.ACKResourceMetadata == nil {
+		r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	resourceARN := ackv1alpha1.AWSResourceName(alarmARN)
+	r.ko.Status.ACKResourceMetadata.ARN = &resourceARN
+	return nil
+}
+
+// checkAlarmARNScope returns a Terminal error if the ARN of the supplied
+// resource, as set when adopting an alarm by ARN, is not in the account and
+// region of the resource manager. The alarm name alone would otherwise
+// adopt a same-named alarm of the resource manager's account and region.
+func (rm *resourceManager) checkAlarmARNScope(r *resource) error {
+	if r.ko.Status.ACKResourceMetadata == nil || r.ko.Status.ACKResourceMetadata.ARN == nil {
+		return nil
+	}
+	alarmARN := string(*r.ko.Status.ACKResourceMetadata.ARN)
+	parsed, err := arn.Parse(alarmARN)
+	if err != nil {
+		return ackerrors.NewTerminalError(fmt.Errorf("invalid alarm ARN %q: %v", alarmARN, err))
+	}
+	if parsed.AccountID != string(rm.awsAccountID) || parsed.Region != string(rm.awsRegion) {
+		return ackerrors.NewTerminalError(fmt.Errorf(
+			"alarm ARN %q is not in account %s and region %s",
+			alarmARN, rm.awsAccountID, rm.awsRegion,
+		))
+	}
+	return nil
+}
+
+// alarmDeletions holds the deletion coalescer of each account and region.
+var alarmDeletions commonutil.DeletionCoalescers
+
+// deletions returns the deletion coalescer of the account and region of the
+// resource manager, which groups the alarms to delete into DeleteAlarms
+// requests.
+func (rm *resourceManager) deletions() *commonutil.DeletionCoalescer {
+	return alarmDeletions.For(scopeKey(string(rm.awsAccountID), string(rm.awsRegion)))
+}
+
+// customDescribeAlarms returns the alarm named in the supplied
+// DescribeAlarms input as last read by the alarm snapshot of the account and
+// region of the resource manager, instead of calling DescribeAlarms for
+// every resource. See alarmSnapshot.
+func (rm *resourceManager) customDescribeAlarms(
+	ctx context.Context,
+	input *svcsdk.DescribeAlarmsInput,
+) (*svcsdk.DescribeAlarmsOutput, error) {
+	resp := &svcsdk.DescribeAlarmsOutput{}
+	for _, name := range input.AlarmNames {
+		alarm, err := rm.alarmSnapshot().get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if alarm != nil {
+			resp.MetricAlarms = append(resp.MetricAlarms, *alarm)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,158 @@
+package metric_alarm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerrors "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+func TestSetIdentifiers_ARN(t *testing.T) {
+	tests := []struct {
+		name     string
+		arn      string
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "alarm ARN",
+			arn:      "arn:aws:cloudwatch:us-west-2:123456789012:alarm:cpu-high",
+			wantName: "cpu-high",
+		},
+		{
+			name:     "alarm name containing a colon",
+			arn:      "arn:aws:cloudwatch:us-west-2:123456789012:alarm:team:cpu-high",
+			wantName: "team:cpu-high",
+		},
+		{
+			name:    "dashboard ARN",
+			arn:     "arn:aws:cloudwatch::123456789012:dashboard/my-dashboard",
+			wantErr: true,
+		},
+		{
+			name:    "ARN of another service",
+			arn:     "arn:aws:sns:us-west-2:123456789012:alarm:cpu-high",
+			wantErr: true,
+		},
+		{
+			name:    "not an ARN",
+			arn:     "cpu-high",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.MetricAlarm{}}
+			arn := ackv1alpha1.AWSResourceName(tt.arn)
+
+			err := r.SetIdentifiers(&ackv1alpha1.AWSIdentifiers{ARN: &arn})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetIdentifiers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if r.ko.Spec.Name == nil || *r.ko.Spec.Name != tt.wantName {
+				t.Errorf("SetIdentifiers() Spec.Name = %v, want %q", r.ko.Spec.Name, tt.wantName)
+			}
+			if got := r.Identifiers().ARN(); got == nil || string(*got) != tt.arn {
+				t.Errorf("SetIdentifiers() ARN = %v, want %q", got, tt.arn)
+			}
+		})
+	}
+}
+
+func TestPopulateResourceFromAnnotation_ARN(t *testing.T) {
+	r := &resource{ko: &svcapitypes.MetricAlarm{}}
+	err := r.PopulateResourceFromAnnotation(map[string]string{
+		"arn": "arn:aws:cloudwatch:us-west-2:123456789012:alarm:cpu-high",
+	})
+	if err != nil {
+		t.Fatalf("PopulateResourceFromAnnotation() error = %v", err)
+	}
+	if r.ko.Spec.Name == nil || *r.ko.Spec.Name != "cpu-high" {
+		t.Errorf("PopulateResourceFromAnnotation() Spec.Name = %v, want %q", r.ko.Spec.Name, "cpu-high")
+	}
+}
+
+func TestCheckAlarmARNScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		arn     string
+		wantErr bool
+	}{
+		{
+			name: "no ARN",
+		},
+		{
+			name: "same account and region",
+			arn:  "arn:aws:cloudwatch:us-west-2:123456789012:alarm:cpu-high",
+		},
+		{
+			name:    "other account",
+			arn:     "arn:aws:cloudwatch:us-west-2:210987654321:alarm:cpu-high",
+			wantErr: true,
+		},
+		{
+			name:    "other region",
+			arn:     "arn:aws:cloudwatch:eu-west-1:123456789012:alarm:cpu-high",
+			wantErr: true,
+		},
+	}
+
+	rm := &resourceManager{awsAccountID: "123456789012", awsRegion: "us-west-2"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &resource{ko: &svcapitypes.MetricAlarm{}}
+			if tt.arn != "" {
+				arn := ackv1alpha1.AWSResourceName(tt.arn)
+				r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{ARN: &arn}
+			}
+
+			err := rm.checkAlarmARNScope(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkAlarmARNScope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var terminal *ackerrors.TerminalError
+			if err != nil && !errors.As(err, &terminal) {
+				t.Errorf("checkAlarmARNScope() error = %v, want a Terminal error", err)
+			}
+		})
+	}
+}
+
+func TestUpdateAlarmStateCondition(t *testing.T) {
+	rm := &resourceManager{}
+	ko := &svcapitypes.MetricAlarm{}
+	ko.Status.StateValue = aws.String("ALARM")
+	ko.Status.StateReason = aws.String("Threshold crossed")
+
+	if !rm.updateAlarmStateCondition(ko) {
+		t.Fatalf("updateAlarmStateCondition() = false on first read, want true")
+	}
+	if rm.updateAlarmStateCondition(ko) {
+		t.Errorf("updateAlarmStateCondition() = true with unchanged state, want false")
+	}
+
+	ko.Status.StateReason = aws.String("Threshold crossed again")
+	if !rm.updateAlarmStateCondition(ko) {
+		t.Errorf("updateAlarmStateCondition() = false with a new reason, want true")
+	}
+
+	ko.Status.StateValue = aws.String("OK")
+	if !rm.updateAlarmStateCondition(ko) {
+		t.Errorf("updateAlarmStateCondition() = false with a new state, want true")
+	}
+	if got := ko.Status.Conditions[0].Status; got != corev1.ConditionFalse {
+		t.Errorf("AlarmFiring status = %v, want %v", got, corev1.ConditionFalse)
+	}
+	if rm.updateAlarmStateCondition(ko) {
+		t.Errorf("updateAlarmStateCondition() = true with unchanged state, want false")
+	}
+}
@@ -17,14 +17,24 @@ package metric_alarm
 
 import (
 	"context"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	snsapitypes "github.com/aws-controllers-k8s/sns-controller/apis/v1alpha1"
 
 	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
 )
 
+// +kubebuilder:rbac:groups=sns.services.k8s.aws,resources=topics,verbs=get;list
+// +kubebuilder:rbac:groups=sns.services.k8s.aws,resources=topics/status,verbs=get;list
+
 // ClearResolvedReferences removes any reference values that were made
 // concrete in the spec. It returns a copy of the input AWSResource which
 // contains the original *Ref values, but none of their respective concrete
@@ -32,6 +42,18 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if len(ko.Spec.AlarmActionRefs) > 0 {
+		ko.Spec.AlarmActions = nil
+	}
+
+	if len(ko.Spec.InsufficientDataActionRefs) > 0 {
+		ko.Spec.InsufficientDataActions = nil
+	}
+
+	if len(ko.Spec.OKActionRefs) > 0 {
+		ko.Spec.OKActions = nil
+	}
+
 	return &resource{ko}
 }
 
@@ -47,11 +69,225 @@ func (rm *resourceManager) ResolveReferences(
 	apiReader client.Reader,
 	res acktypes.AWSResource,
 ) (acktypes.AWSResource, bool, error) {
-	return res, false, nil
+	ko := rm.concreteResource(res).ko
+
+	resourceHasReferences := false
+	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForAlarmActions(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForInsufficientDataActions(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForOKActions(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	return &resource{ko}, resourceHasReferences, err
 }
 
 // validateReferenceFields validates the reference field and corresponding
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.MetricAlarm) error {
+
+	if len(ko.Spec.AlarmActionRefs) > 0 && len(ko.Spec.AlarmActions) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("AlarmActions", "AlarmActionRefs")
+	}
+
+	if len(ko.Spec.InsufficientDataActionRefs) > 0 && len(ko.Spec.InsufficientDataActions) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("InsufficientDataActions", "InsufficientDataActionRefs")
+	}
+
+	if len(ko.Spec.OKActionRefs) > 0 && len(ko.Spec.OKActions) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("OKActions", "OKActionRefs")
+	}
 	return nil
 }
+
+// resolveReferenceForAlarmActions reads the resource referenced
+// from AlarmActionRefs field and sets the AlarmActions
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForAlarmActions(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.MetricAlarm,
+) (hasReferences bool, err error) {
+	for _, f0iter := range ko.Spec.AlarmActionRefs {
+		if f0iter != nil && f0iter.From != nil {
+			hasReferences = true
+			arr := f0iter.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: AlarmActionRefs")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &snsapitypes.Topic{}
+			if err := getReferencedResourceState_Topic(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			if ko.Spec.AlarmActions == nil {
+				ko.Spec.AlarmActions = make([]*string, 0, 1)
+			}
+			ko.Spec.AlarmActions = append(ko.Spec.AlarmActions, (*string)(obj.Status.ACKResourceMetadata.ARN))
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_Topic looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_Topic(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *snsapitypes.Topic,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"Topic",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"Topic",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"Topic",
+			namespace, name)
+	}
+	if obj.Status.ACKResourceMetadata == nil || obj.Status.ACKResourceMetadata.ARN == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"Topic",
+			namespace, name,
+			"Status.ACKResourceMetadata.ARN")
+	}
+	return nil
+}
+
+// resolveReferenceForInsufficientDataActions reads the resource referenced
+// from InsufficientDataActionRefs field and sets the InsufficientDataActions
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForInsufficientDataActions(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.MetricAlarm,
+) (hasReferences bool, err error) {
+	for _, f0iter := range ko.Spec.InsufficientDataActionRefs {
+		if f0iter != nil && f0iter.From != nil {
+			hasReferences = true
+			arr := f0iter.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: InsufficientDataActionRefs")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &snsapitypes.Topic{}
+			if err := getReferencedResourceState_Topic(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			if ko.Spec.InsufficientDataActions == nil {
+				ko.Spec.InsufficientDataActions = make([]*string, 0, 1)
+			}
+			ko.Spec.InsufficientDataActions = append(ko.Spec.InsufficientDataActions, (*string)(obj.Status.ACKResourceMetadata.ARN))
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// resolveReferenceForOKActions reads the resource referenced
+// from OKActionRefs field and sets the OKActions
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForOKActions(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.MetricAlarm,
+) (hasReferences bool, err error) {
+	for _, f0iter := range ko.Spec.OKActionRefs {
+		if f0iter != nil && f0iter.From != nil {
+			hasReferences = true
+			arr := f0iter.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: OKActionRefs")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &snsapitypes.Topic{}
+			if err := getReferencedResourceState_Topic(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			if ko.Spec.OKActions == nil {
+				ko.Spec.OKActions = make([]*string, 0, 1)
+			}
+			ko.Spec.OKActions = append(ko.Spec.OKActions, (*string)(obj.Status.ACKResourceMetadata.ARN))
+		}
+	}
+
+	return hasReferences, nil
+}
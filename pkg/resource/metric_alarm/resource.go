@@ -87,6 +87,9 @@ func (r *resource) SetStatus(desired acktypes.AWSResource) {
 // SetIdentifiers sets the Spec or Status field that is referenced as the unique
 // resource identifier
 func (r *resource) SetIdentifiers(identifier *ackv1alpha1.AWSIdentifiers) error {
+	if identifier.NameOrID == "" && identifier.ARN != nil {
+		return r.setIdentifiersFromARN(string(*identifier.ARN))
+	}
 	if identifier.NameOrID == "" {
 		return ackerrors.MissingNameIdentifier
 	}
@@ -97,6 +100,9 @@ func (r *resource) SetIdentifiers(identifier *ackv1alpha1.AWSIdentifiers) error
 
 // PopulateResourceFromAnnotation populates the fields passed from adoption annotation
 func (r *resource) PopulateResourceFromAnnotation(fields map[string]string) error {
+	if arn, ok := fields["arn"]; ok && fields["name"] == "" {
+		return r.setIdentifiersFromARN(arn)
+	}
 	primaryKey, ok := fields["name"]
 	if !ok {
 		return ackerrors.NewTerminalError(fmt.Errorf("required field missing: name"))
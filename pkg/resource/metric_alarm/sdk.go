@@ -37,6 +37,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/cloudwatch-controller/pkg/drift"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
 )
 
 // Hack to avoid import errors during build...
@@ -75,11 +77,15 @@ func (rm *resourceManager) sdkFind(
 	if err != nil {
 		return nil, err
 	}
+	if err := rm.checkAlarmARNScope(r); err != nil {
+		return nil, err
+	}
 	input.AlarmNames = []string{*r.ko.Spec.Name}
 
 	var resp *svcsdk.DescribeAlarmsOutput
-	resp, err = rm.sdkapi.DescribeAlarms(ctx, input)
+	resp, err = rm.customDescribeAlarms(ctx, input)
 	rm.metrics.RecordAPICall("READ_MANY", "DescribeAlarms", err)
+	err = commonutil.RequeueOnAPIError(err)
 	if err != nil {
 		var awsErr smithy.APIError
 		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "UNKNOWN" {
@@ -299,6 +305,31 @@ func (rm *resourceManager) sdkFind(
 		} else {
 			ko.Spec.Period = nil
 		}
+		if elem.StateReason != nil {
+			ko.Status.StateReason = elem.StateReason
+		} else {
+			ko.Status.StateReason = nil
+		}
+		if elem.StateReasonData != nil {
+			ko.Status.StateReasonData = elem.StateReasonData
+		} else {
+			ko.Status.StateReasonData = nil
+		}
+		if elem.StateTransitionedTimestamp != nil {
+			ko.Status.StateTransitionedTimestamp = &metav1.Time{Time: *elem.StateTransitionedTimestamp}
+		} else {
+			ko.Status.StateTransitionedTimestamp = nil
+		}
+		if elem.StateUpdatedTimestamp != nil {
+			ko.Status.StateUpdatedTimestamp = &metav1.Time{Time: *elem.StateUpdatedTimestamp}
+		} else {
+			ko.Status.StateUpdatedTimestamp = nil
+		}
+		if elem.StateValue != "" {
+			ko.Status.StateValue = aws.String(string(elem.StateValue))
+		} else {
+			ko.Status.StateValue = nil
+		}
 		if elem.Statistic != "" {
 			ko.Spec.Statistic = aws.String(string(elem.Statistic))
 		} else {
@@ -331,6 +362,28 @@ func (rm *resourceManager) sdkFind(
 		return nil, ackerr.NotFound
 	}
 
+	for _, elem := range resp.MetricAlarms {
+		if elem.AlarmArn == nil || aws.ToString(elem.AlarmName) != *ko.Spec.Name {
+			continue
+		}
+		if ko.Status.ACKResourceMetadata == nil {
+			ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+		}
+		arn := ackv1alpha1.AWSResourceName(*elem.AlarmArn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+		break
+	}
+	sortAlarmLists(ko)
+	rm.restoreTypedActions(ko, r.ko)
+	if ko.Spec.Tags, err = rm.alarmSnapshot().tags(ctx, *ko.Spec.Name, func(ctx context.Context) ([]*svcapitypes.Tag, error) {
+		return commonutil.GetTags(
+			ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+			ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+		)
+	}); err != nil {
+		return nil, err
+	}
+	drift.Observe(&resourceDescriptor{}, &resource{ko})
 	rm.setStatusDefaults(ko)
 	return &resource{ko}, nil
 }
@@ -370,11 +423,16 @@ func (rm *resourceManager) sdkCreate(
 	if err != nil {
 		return nil, err
 	}
+	if err := rm.setAlarmActions(input, desired); err != nil {
+		return nil, err
+	}
 
 	var resp *svcsdk.PutMetricAlarmOutput
 	_ = resp
 	resp, err = rm.sdkapi.PutMetricAlarm(ctx, input)
 	rm.metrics.RecordAPICall("CREATE", "PutMetricAlarm", err)
+	rm.alarmSnapshot().invalidate(*input.AlarmName)
+	err = commonutil.RequeueOnAPIError(err)
 	if err != nil {
 		return nil, err
 	}
@@ -654,15 +712,35 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	drift.Forget(&resourceDescriptor{}, desired)
+	if delta.DifferentAt("Spec.Tags") {
+		if err = commonutil.SyncTags(
+			ctx, rm.sdkapi, rm.metrics, latest.ko.Status.ACKResourceMetadata,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+			rm.cfg.ResourceTagKeys, convertToOrderedACKTags,
+			syncAWSTags, ignoreSystemTags,
+		); err != nil {
+			return nil, err
+		}
+		rm.alarmSnapshot().invalidate(*desired.ko.Spec.Name)
+	}
+	if !delta.DifferentExcept("Spec.Tags") {
+		return desired, nil
+	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
 		return nil, err
 	}
+	if err := rm.setAlarmActions(input, desired); err != nil {
+		return nil, err
+	}
 
 	var resp *svcsdk.PutMetricAlarmOutput
 	_ = resp
 	resp, err = rm.sdkapi.PutMetricAlarm(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "PutMetricAlarm", err)
+	rm.alarmSnapshot().invalidate(*input.AlarmName)
+	err = commonutil.RequeueOnAPIError(err)
 	if err != nil {
 		return nil, err
 	}
@@ -944,12 +1022,18 @@ func (rm *resourceManager) sdkDelete(
 	if err != nil {
 		return nil, err
 	}
-	input.AlarmNames = []string{*r.ko.Spec.Name}
+	drift.Forget(&resourceDescriptor{}, r)
+	if input.AlarmNames, err = rm.deletions().Next(*r.ko.Spec.Name); err != nil {
+		return nil, err
+	}
 
 	var resp *svcsdk.DeleteAlarmsOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteAlarms(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteAlarms", err)
+	rm.alarmSnapshot().invalidate(input.AlarmNames...)
+	err = rm.deletions().Done(input.AlarmNames, err)
+	err = commonutil.RequeueOnAPIError(err)
 	return nil, err
 }
 
@@ -1053,9 +1137,9 @@ func (rm *resourceManager) updateConditions(
 			recoverableCondition.Message = nil
 		}
 	}
-	// Required to avoid the "declared but not used" error in the default case
-	_ = syncCondition
-	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil {
+	// custom update conditions
+	customUpdate := rm.updateCustomConditions(ko, r, err)
+	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil || customUpdate {
 		return &resource{ko}, true // updated
 	}
 	return nil, false // not updated
@@ -1065,6 +1149,20 @@ func (rm *resourceManager) updateConditions(
 // and if the exception indicates that it is a Terminal exception
 // 'Terminal' exception are specified in generator configuration
 func (rm *resourceManager) terminalAWSError(err error) bool {
-	// No terminal_errors specified for this resource in generator config
-	return false
+	if err == nil {
+		return false
+	}
+
+	var terminalErr smithy.APIError
+	if !errors.As(err, &terminalErr) {
+		return false
+	}
+	switch terminalErr.ErrorCode() {
+	case "InvalidParameterValue",
+		"InvalidParameterCombination",
+		"MissingParameter":
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,391 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metric_alarm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+const (
+	// alarmSnapshotTTL is how long an alarm read from CloudWatch is served
+	// from the alarm snapshot. It is kept well below the resync period, so
+	// that a resync still reads recent state.
+	alarmSnapshotTTL = time.Minute
+	// maxDescribeAlarmNames is the maximum number of alarm names in a
+	// DescribeAlarms request.
+	maxDescribeAlarmNames = 100
+)
+
+var (
+	alarmNamesMu sync.Mutex
+	// alarmNames holds, by account and region, the names of the MetricAlarm
+	// resources in the cluster. The alarm snapshot of an account and region
+	// reads them together with the alarm it is asked for, so that
+	// reconciling every resource, as on controller start, costs one
+	// DescribeAlarms call per maxDescribeAlarmNames resources.
+	alarmNames = map[string]*nameSet{}
+
+	snapshotsMu sync.Mutex
+	// snapshots holds the alarm snapshot of each account and region.
+	snapshots = map[string]*alarmSnapshot{}
+)
+
+// scopeKey returns the key of the supplied account and region in
+// alarmNames, snapshots and alarmDeletions.
+func scopeKey(accountID, region string) string {
+	return accountID + "/" + region
+}
+
+// alarmNamesOf returns the name set of the account and region with the
+// supplied key.
+func alarmNamesOf(key string) *nameSet {
+	alarmNamesMu.Lock()
+	defer alarmNamesMu.Unlock()
+	names, ok := alarmNames[key]
+	if !ok {
+		names = newNameSet()
+		alarmNames[key] = names
+	}
+	return names
+}
+
+// nameSet counts the resources using each alarm name, since several
+// resources may target the same alarm.
+type nameSet struct {
+	mu    sync.Mutex
+	names map[string]int
+}
+
+func newNameSet() *nameSet {
+	return &nameSet{names: map[string]int{}}
+}
+
+func (s *nameSet) add(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.names[name]++
+}
+
+func (s *nameSet) remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.names[name] <= 1 {
+		delete(s.names, name)
+		return
+	}
+	s.names[name]--
+}
+
+// list returns the names in the set.
+func (s *nameSet) list() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.names))
+	for name := range s.names {
+		names = append(names, name)
+	}
+	return names
+}
+
+// SetupAlarmSnapshot registers with the supplied manager an event handler
+// keeping track of the names of the MetricAlarm resources, by account and
+// region, which the alarm snapshots read in batches. It does nothing when
+// MetricAlarms are not reconciled.
+func SetupAlarmSnapshot(mgr ctrlrt.Manager, sc acktypes.ServiceController) error {
+	reconciled := false
+	for _, rec := range sc.GetReconcilers() {
+		if rec.GroupVersionKind().Kind == GroupKind.Kind {
+			reconciled = true
+		}
+	}
+	if !reconciled {
+		return nil
+	}
+	informer, err := mgr.GetCache().GetInformer(context.Background(), &svcapitypes.MetricAlarm{})
+	if err != nil {
+		return err
+	}
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if key, name := metricAlarmName(obj); name != "" {
+				alarmNamesOf(key).add(name)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldKey, oldName := metricAlarmName(oldObj)
+			newKey, newName := metricAlarmName(newObj)
+			if oldKey == newKey && oldName == newName {
+				return
+			}
+			if oldName != "" {
+				alarmNamesOf(oldKey).remove(oldName)
+			}
+			if newName != "" {
+				alarmNamesOf(newKey).add(newName)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if key, name := metricAlarmName(obj); name != "" {
+				alarmNamesOf(key).remove(name)
+			}
+		},
+	})
+	return err
+}
+
+// metricAlarmName returns the alarm name of the supplied MetricAlarm and
+// the key of the account and region it was last reconciled in, or empty
+// strings. MetricAlarms that were never reconciled are left out, their
+// alarms are read on their own.
+func metricAlarmName(obj any) (key string, name string) {
+	ko, ok := obj.(*svcapitypes.MetricAlarm)
+	if !ok || ko.Spec.Name == nil {
+		return "", ""
+	}
+	metadata := ko.Status.ACKResourceMetadata
+	if metadata == nil || metadata.OwnerAccountID == nil || metadata.Region == nil {
+		return "", ""
+	}
+	return scopeKey(string(*metadata.OwnerAccountID), string(*metadata.Region)), *ko.Spec.Name
+}
+
+// alarmSnapshotEntry is an alarm read from CloudWatch, nil when it does not
+// exist, and when it was read. The tags of the alarm are added the first
+// time they are asked for, see alarmSnapshot.tags.
+type alarmSnapshotEntry struct {
+	alarm  *svcsdktypes.MetricAlarm
+	readAt time.Time
+
+	tags     []*svcapitypes.Tag
+	tagsRead bool
+}
+
+// alarmSnapshot serves the alarms of one account and region, and their tags,
+// read from CloudWatch in batches of up to maxDescribeAlarmNames names, for
+// alarmSnapshotTTL. Every read includes, besides the requested alarm, the
+// other alarms of alarmNames that are not served anymore, and concurrent
+// requests for an alarm being read wait for that read.
+type alarmSnapshot struct {
+	// describe returns the alarms with the supplied names, by name.
+	describe func(ctx context.Context, names []string) (map[string]*svcsdktypes.MetricAlarm, error)
+	names    *nameSet
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]alarmSnapshotEntry
+	// reading holds, by name, a channel closed when the read including the
+	// alarm completes.
+	reading map[string]chan struct{}
+	// invalidations counts, by name, the calls to invalidate, so that a read
+	// started before the alarm was changed is not stored.
+	invalidations map[string]uint64
+}
+
+func newAlarmSnapshot(
+	describe func(ctx context.Context, names []string) (map[string]*svcsdktypes.MetricAlarm, error),
+	names *nameSet,
+) *alarmSnapshot {
+	return &alarmSnapshot{
+		describe:      describe,
+		names:         names,
+		now:           time.Now,
+		entries:       map[string]alarmSnapshotEntry{},
+		reading:       map[string]chan struct{}{},
+		invalidations: map[string]uint64{},
+	}
+}
+
+// alarmSnapshot returns the alarm snapshot of the account and region of the
+// resource manager.
+func (rm *resourceManager) alarmSnapshot() *alarmSnapshot {
+	key := scopeKey(string(rm.awsAccountID), string(rm.awsRegion))
+	snapshotsMu.Lock()
+	defer snapshotsMu.Unlock()
+	s, ok := snapshots[key]
+	if !ok {
+		s = newAlarmSnapshot(rm.describeAlarms, alarmNamesOf(key))
+		snapshots[key] = s
+	}
+	return s
+}
+
+// describeAlarms returns the metric alarms with the supplied names, by name,
+// reading every page of the DescribeAlarms results.
+func (rm *resourceManager) describeAlarms(
+	ctx context.Context,
+	names []string,
+) (map[string]*svcsdktypes.MetricAlarm, error) {
+	alarms := map[string]*svcsdktypes.MetricAlarm{}
+	paginator := svcsdk.NewDescribeAlarmsPaginator(rm.sdkapi, &svcsdk.DescribeAlarmsInput{
+		AlarmNames: names,
+		MaxRecords: aws.Int32(maxDescribeAlarmNames),
+	})
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeAlarms", err)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.MetricAlarms {
+			if alarm := &resp.MetricAlarms[i]; alarm.AlarmName != nil {
+				alarms[*alarm.AlarmName] = alarm
+			}
+		}
+	}
+	return alarms, nil
+}
+
+// get returns the alarm with the supplied name, or nil when it does not
+// exist, reading it from CloudWatch unless the snapshot still serves it.
+func (s *alarmSnapshot) get(ctx context.Context, name string) (*svcsdktypes.MetricAlarm, error) {
+	for {
+		s.mu.Lock()
+		if entry, ok := s.entries[name]; ok && s.fresh(entry) {
+			s.mu.Unlock()
+			return entry.alarm, nil
+		}
+		if done, ok := s.reading[name]; ok {
+			s.mu.Unlock()
+			select {
+			case <-done:
+				// The read may have failed, in which case the alarm is read
+				// again.
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		names := s.batch(name)
+		done := make(chan struct{})
+		invalidations := make([]uint64, len(names))
+		for i, n := range names {
+			s.reading[n] = done
+			invalidations[i] = s.invalidations[n]
+		}
+		s.mu.Unlock()
+
+		alarms, err := s.describe(ctx, names)
+
+		s.mu.Lock()
+		readAt := s.now()
+		for i, n := range names {
+			delete(s.reading, n)
+			if err == nil && s.invalidations[n] == invalidations[i] {
+				s.entries[n] = alarmSnapshotEntry{alarm: alarms[n], readAt: readAt}
+			}
+		}
+		close(done)
+		s.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		return alarms[name], nil
+	}
+}
+
+// batch returns the supplied name followed by up to
+// maxDescribeAlarmNames-1 other names of the name set that the snapshot
+// does not serve and that are not being read. It is called with s.mu held.
+func (s *alarmSnapshot) batch(name string) []string {
+	names := []string{name}
+	for _, n := range s.names.list() {
+		if len(names) == maxDescribeAlarmNames {
+			break
+		}
+		if n == name {
+			continue
+		}
+		if _, ok := s.reading[n]; ok {
+			continue
+		}
+		if entry, ok := s.entries[n]; ok && s.fresh(entry) {
+			continue
+		}
+		names = append(names, n)
+	}
+	return names
+}
+
+// fresh returns true while the supplied entry is served.
+func (s *alarmSnapshot) fresh(entry alarmSnapshotEntry) bool {
+	return s.now().Sub(entry.readAt) < alarmSnapshotTTL
+}
+
+// invalidate drops the supplied alarms from the snapshot, after they were
+// changed in CloudWatch, so that they are read again.
+func (s *alarmSnapshot) invalidate(names ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range names {
+		delete(s.entries, name)
+		s.invalidations[name]++
+	}
+}
+
+// tags returns the tags of the alarm with the supplied name, calling list
+// unless the snapshot serves them. The tags are served with the alarm, until
+// it is read again or invalidated.
+func (s *alarmSnapshot) tags(
+	ctx context.Context,
+	name string,
+	list func(ctx context.Context) ([]*svcapitypes.Tag, error),
+) ([]*svcapitypes.Tag, error) {
+	s.mu.Lock()
+	entry, ok := s.entries[name]
+	invalidations := s.invalidations[name]
+	s.mu.Unlock()
+	if ok && entry.tagsRead && s.fresh(entry) {
+		return copyTags(entry.tags), nil
+	}
+
+	tags, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.entries[name]; ok && s.invalidations[name] == invalidations {
+		entry.tags = copyTags(tags)
+		entry.tagsRead = true
+		s.entries[name] = entry
+	}
+	return tags, nil
+}
+
+// copyTags returns a deep copy of the supplied tags, so that the tags served
+// by the snapshot are not changed by the resources using them.
+func copyTags(tags []*svcapitypes.Tag) []*svcapitypes.Tag {
+	if tags == nil {
+		return nil
+	}
+	copied := make([]*svcapitypes.Tag, 0, len(tags))
+	for _, t := range tags {
+		copied = append(copied, t.DeepCopy())
+	}
+	return copied
+}
@@ -0,0 +1,242 @@
+package metric_alarm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// fakeDescribe records the names of each DescribeAlarms call and returns the
+// alarms in existing.
+type fakeDescribe struct {
+	existing map[string]bool
+	err      error
+	calls    [][]string
+}
+
+func (f *fakeDescribe) describe(_ context.Context, names []string) (map[string]*svcsdktypes.MetricAlarm, error) {
+	f.calls = append(f.calls, names)
+	if f.err != nil {
+		return nil, f.err
+	}
+	alarms := map[string]*svcsdktypes.MetricAlarm{}
+	for _, name := range names {
+		if f.existing[name] {
+			alarms[name] = &svcsdktypes.MetricAlarm{AlarmName: aws.String(name)}
+		}
+	}
+	return alarms, nil
+}
+
+func newTestSnapshot(f *fakeDescribe, names ...string) (*alarmSnapshot, *time.Time) {
+	set := newNameSet()
+	for _, name := range names {
+		set.add(name)
+	}
+	s := newAlarmSnapshot(f.describe, set)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func TestAlarmSnapshotBatches(t *testing.T) {
+	var names []string
+	existing := map[string]bool{}
+	for i := range 250 {
+		name := fmt.Sprintf("alarm-%03d", i)
+		names = append(names, name)
+		existing[name] = true
+	}
+	f := &fakeDescribe{existing: existing}
+	s, _ := newTestSnapshot(f, names...)
+	ctx := context.Background()
+
+	for _, name := range names {
+		alarm, err := s.get(ctx, name)
+		if err != nil {
+			t.Fatalf("get(%q) error = %v", name, err)
+		}
+		if alarm == nil || *alarm.AlarmName != name {
+			t.Fatalf("get(%q) = %v", name, alarm)
+		}
+	}
+	if len(f.calls) != 3 {
+		t.Fatalf("DescribeAlarms called %d times, want 3", len(f.calls))
+	}
+	read := map[string]bool{}
+	for _, call := range f.calls {
+		if len(call) > maxDescribeAlarmNames {
+			t.Errorf("DescribeAlarms called with %d names", len(call))
+		}
+		for _, name := range call {
+			if read[name] {
+				t.Errorf("%q read twice", name)
+			}
+			read[name] = true
+		}
+	}
+}
+
+func TestAlarmSnapshotGet(t *testing.T) {
+	ctx := context.Background()
+	t.Run("served until the TTL elapses", func(t *testing.T) {
+		f := &fakeDescribe{existing: map[string]bool{"cpu": true}}
+		s, now := newTestSnapshot(f, "cpu")
+		s.get(ctx, "cpu")
+		*now = now.Add(alarmSnapshotTTL - time.Second)
+		s.get(ctx, "cpu")
+		if len(f.calls) != 1 {
+			t.Errorf("DescribeAlarms called %d times, want 1", len(f.calls))
+		}
+		*now = now.Add(time.Second)
+		s.get(ctx, "cpu")
+		if len(f.calls) != 2 {
+			t.Errorf("DescribeAlarms called %d times, want 2", len(f.calls))
+		}
+	})
+	t.Run("missing alarm", func(t *testing.T) {
+		f := &fakeDescribe{}
+		s, _ := newTestSnapshot(f)
+		for range 2 {
+			if alarm, err := s.get(ctx, "cpu"); alarm != nil || err != nil {
+				t.Errorf("get() = %v, %v, want nil, nil", alarm, err)
+			}
+		}
+		if len(f.calls) != 1 {
+			t.Errorf("DescribeAlarms called %d times, want 1", len(f.calls))
+		}
+	})
+	t.Run("invalidated", func(t *testing.T) {
+		f := &fakeDescribe{existing: map[string]bool{"cpu": true, "disk": true}}
+		s, _ := newTestSnapshot(f, "cpu", "disk")
+		s.get(ctx, "cpu")
+		s.invalidate("cpu")
+		s.get(ctx, "disk")
+		s.get(ctx, "cpu")
+		if len(f.calls) != 2 || len(f.calls[1]) != 1 || f.calls[1][0] != "cpu" {
+			t.Errorf("DescribeAlarms calls = %v, want [[cpu disk] [cpu]]", f.calls)
+		}
+	})
+	t.Run("invalidated while read", func(t *testing.T) {
+		f := &fakeDescribe{existing: map[string]bool{"cpu": true}}
+		s, _ := newTestSnapshot(f, "cpu")
+		s.describe = func(ctx context.Context, names []string) (map[string]*svcsdktypes.MetricAlarm, error) {
+			s.invalidate("cpu")
+			return f.describe(ctx, names)
+		}
+		s.get(ctx, "cpu")
+		s.describe = f.describe
+		s.get(ctx, "cpu")
+		if len(f.calls) != 2 {
+			t.Errorf("DescribeAlarms called %d times, want 2", len(f.calls))
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		f := &fakeDescribe{err: errors.New("throttled")}
+		s, _ := newTestSnapshot(f, "cpu")
+		if _, err := s.get(ctx, "cpu"); err == nil {
+			t.Fatal("get() error = nil")
+		}
+		f.err = nil
+		s.get(ctx, "cpu")
+		if len(f.calls) != 2 {
+			t.Errorf("DescribeAlarms called %d times, want 2", len(f.calls))
+		}
+	})
+}
+
+func TestAlarmSnapshotTags(t *testing.T) {
+	f := &fakeDescribe{existing: map[string]bool{"cpu-high": true}}
+	s, now := newTestSnapshot(f, "cpu-high")
+	ctx := context.Background()
+	lists := 0
+	list := func(context.Context) ([]*svcapitypes.Tag, error) {
+		lists++
+		return []*svcapitypes.Tag{{Key: aws.String("env"), Value: aws.String("prod")}}, nil
+	}
+	assertTags := func(wantLists int) {
+		t.Helper()
+		if _, err := s.get(ctx, "cpu-high"); err != nil {
+			t.Fatalf("get() error = %v", err)
+		}
+		tags, err := s.tags(ctx, "cpu-high", list)
+		if err != nil || len(tags) != 1 || *tags[0].Value != "prod" {
+			t.Fatalf("tags() = %v, %v, want the listed tags", tags, err)
+		}
+		// The served tags are copies.
+		tags[0].Value = aws.String("dev")
+		if lists != wantLists {
+			t.Errorf("tags were listed %d times, want %d", lists, wantLists)
+		}
+	}
+
+	assertTags(1)
+	assertTags(1)
+	// Tags are listed again with an invalidated alarm...
+	s.invalidate("cpu-high")
+	assertTags(2)
+	// ...and with an alarm read again.
+	*now = now.Add(alarmSnapshotTTL)
+	assertTags(3)
+	assertTags(3)
+}
+
+func TestNameSet(t *testing.T) {
+	s := newNameSet()
+	s.add("cpu")
+	s.add("cpu")
+	s.remove("cpu")
+	if got := s.list(); len(got) != 1 {
+		t.Errorf("list() = %v, want [cpu]", got)
+	}
+	s.remove("cpu")
+	if got := s.list(); len(got) != 0 {
+		t.Errorf("list() = %v, want []", got)
+	}
+}
+
+func TestMetricAlarmName(t *testing.T) {
+	account := ackv1alpha1.AWSAccountID("111122223333")
+	region := ackv1alpha1.AWSRegion("us-west-2")
+	tests := []struct {
+		name     string
+		ko       *svcapitypes.MetricAlarm
+		wantKey  string
+		wantName string
+	}{
+		{
+			name: "reconciled alarm",
+			ko: &svcapitypes.MetricAlarm{
+				Spec: svcapitypes.MetricAlarmSpec{Name: aws.String("cpu")},
+				Status: svcapitypes.MetricAlarmStatus{
+					ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{OwnerAccountID: &account, Region: &region},
+				},
+			},
+			wantKey:  "111122223333/us-west-2",
+			wantName: "cpu",
+		},
+		{
+			name: "alarm never reconciled",
+			ko: &svcapitypes.MetricAlarm{
+				Spec: svcapitypes.MetricAlarmSpec{Name: aws.String("cpu")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, name := metricAlarmName(tt.ko)
+			if key != tt.wantKey || name != tt.wantName {
+				t.Errorf("metricAlarmName() = %q, %q, want %q, %q", key, name, tt.wantKey, tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metric_alarm
+
+import (
+	"context"
+	"fmt"
+
+	ackrtwebhook "github.com/aws-controllers-k8s/runtime/pkg/webhook"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+const (
+	// maxMetricDataQueries is the number of MetricDataQuery entries
+	// PutMetricAlarm accepts in Metrics.
+	maxMetricDataQueries = 20
+)
+
+// wallClockWindowPeriods are the periods, in seconds, that an alarm using a
+// WallClockWindow may evaluate over.
+var wallClockWindowPeriods = map[int64]bool{
+	60:     true,
+	300:    true,
+	3600:   true,
+	86400:  true,
+	604800: true,
+}
+
+// +kubebuilder:webhook:path=/validate-cloudwatch-services-k8s-aws-v1alpha1-metricalarm,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloudwatch.services.k8s.aws,resources=metricalarms,verbs=create;update,versions=v1alpha1,name=vmetricalarm.cloudwatch.services.k8s.aws,admissionReviewVersions=v1
+
+// validator rejects MetricAlarms whose spec PutMetricAlarm would refuse, so
+// that the error is returned at apply time instead of surfacing as a
+// recoverable error on every reconcile.
+type validator struct{}
+
+// ValidateCreate validates the spec of a MetricAlarm being created.
+func (v *validator) ValidateCreate(
+	ctx context.Context,
+	obj *svcapitypes.MetricAlarm,
+) (admission.Warnings, error) {
+	return nil, validateMetricAlarm(obj)
+}
+
+// ValidateUpdate validates the spec of a MetricAlarm being updated. Updates
+// leaving the spec unchanged, such as the status and finalizer updates of
+// the controller, and updates of a MetricAlarm being deleted are allowed, so
+// that a MetricAlarm created before a check was added can still be
+// reconciled and deleted.
+func (v *validator) ValidateUpdate(
+	ctx context.Context,
+	oldObj *svcapitypes.MetricAlarm,
+	newObj *svcapitypes.MetricAlarm,
+) (admission.Warnings, error) {
+	if newObj.DeletionTimestamp != nil || equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	return nil, validateMetricAlarm(newObj)
+}
+
+// ValidateDelete allows every MetricAlarm to be deleted.
+func (v *validator) ValidateDelete(
+	ctx context.Context,
+	obj *svcapitypes.MetricAlarm,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateMetricAlarm returns an Invalid API error listing every problem
+// found in the spec of the supplied MetricAlarm, or nil if there is none.
+func validateMetricAlarm(ko *svcapitypes.MetricAlarm) error {
+	errs := validateMetricAlarmSpec(&ko.Spec, field.NewPath("spec"))
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupKind.Group, Kind: GroupKind.Kind},
+		ko.Name,
+		errs,
+	)
+}
+
+// validateMetricAlarmSpec checks the rules PutMetricAlarm enforces across
+// fields of the spec.
+func validateMetricAlarmSpec(
+	spec *svcapitypes.MetricAlarmSpec,
+	path *field.Path,
+) field.ErrorList {
+	errs := field.ErrorList{}
+	if spec.EvaluationCriteria != nil {
+		errs = append(errs, validatePromQLAlarm(spec, path)...)
+	} else if spec.EvaluationInterval != nil {
+		errs = append(errs, field.Forbidden(
+			path.Child("evaluationInterval"),
+			"may only be set together with evaluationCriteria",
+		))
+	}
+	errs = append(errs, validateEvaluationWindow(spec, path)...)
+	errs = append(errs, validateMetrics(spec.Metrics, path.Child("metrics"))...)
+	return errs
+}
+
+// validatePromQLAlarm checks that a PromQL alarm sets evaluationInterval and
+// none of the fields of a metric-based alarm.
+func validatePromQLAlarm(
+	spec *svcapitypes.MetricAlarmSpec,
+	path *field.Path,
+) field.ErrorList {
+	errs := field.ErrorList{}
+	if spec.EvaluationInterval == nil {
+		errs = append(errs, field.Required(
+			path.Child("evaluationInterval"),
+			"must be set together with evaluationCriteria",
+		))
+	}
+	metricFields := []struct {
+		name string
+		set  bool
+	}{
+		{"metricName", spec.MetricName != nil},
+		{"namespace", spec.Namespace != nil},
+		{"dimensions", len(spec.Dimensions) > 0},
+		{"period", spec.Period != nil},
+		{"unit", spec.Unit != nil},
+		{"statistic", spec.Statistic != nil},
+		{"extendedStatistic", spec.ExtendedStatistic != nil},
+		{"metrics", len(spec.Metrics) > 0},
+		{"threshold", spec.Threshold != nil},
+		{"comparisonOperator", spec.ComparisonOperator != nil},
+		{"thresholdMetricID", spec.ThresholdMetricID != nil},
+		{"evaluationPeriods", spec.EvaluationPeriods != nil},
+		{"datapointsToAlarm", spec.DatapointsToAlarm != nil},
+		{"evaluationWindow", spec.EvaluationWindow != nil},
+	}
+	for _, f := range metricFields {
+		if f.set {
+			errs = append(errs, field.Forbidden(
+				path.Child(f.name),
+				"may not be set together with evaluationCriteria",
+			))
+		}
+	}
+	return errs
+}
+
+// validateEvaluationWindow checks that exactly one member of the
+// evaluationWindow union is set and that an alarm using a wall clock window
+// evaluates over one of the supported periods.
+func validateEvaluationWindow(
+	spec *svcapitypes.MetricAlarmSpec,
+	path *field.Path,
+) field.ErrorList {
+	window := spec.EvaluationWindow
+	if window == nil {
+		return nil
+	}
+	windowPath := path.Child("evaluationWindow")
+	if window.SlidingWindow == nil && window.WallClockWindow == nil {
+		return field.ErrorList{field.Required(
+			windowPath, "one of slidingWindow or wallClockWindow must be set",
+		)}
+	}
+	if window.WallClockWindow == nil {
+		return nil
+	}
+	if window.SlidingWindow != nil {
+		return field.ErrorList{field.Forbidden(
+			windowPath.Child("slidingWindow"),
+			"may not be set together with wallClockWindow",
+		)}
+	}
+	errs := field.ErrorList{}
+	if spec.Period != nil {
+		errs = append(errs, validateWallClockPeriod(
+			*spec.Period, path.Child("period"),
+		)...)
+	}
+	for i, query := range spec.Metrics {
+		if query == nil {
+			continue
+		}
+		queryPath := path.Child("metrics").Index(i)
+		if query.Period != nil {
+			errs = append(errs, validateWallClockPeriod(
+				*query.Period, queryPath.Child("period"),
+			)...)
+		}
+		if query.MetricStat != nil && query.MetricStat.Period != nil {
+			errs = append(errs, validateWallClockPeriod(
+				*query.MetricStat.Period, queryPath.Child("metricStat", "period"),
+			)...)
+		}
+	}
+	return errs
+}
+
+func validateWallClockPeriod(period int64, path *field.Path) field.ErrorList {
+	if wallClockWindowPeriods[period] {
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(
+		path, period, []string{"60", "300", "3600", "86400", "604800"},
+	)}
+}
+
+// validateMetrics checks the MetricDataQuery entries of a metric math or
+// multi-metric alarm. Exactly one entry must return data - the value that
+// the alarm compares with its threshold. CloudWatch treats an entry without
+// returnData as returning data, so those are counted too.
+func validateMetrics(
+	metrics []*svcapitypes.MetricDataQuery,
+	path *field.Path,
+) field.ErrorList {
+	if len(metrics) == 0 {
+		return nil
+	}
+	errs := field.ErrorList{}
+	if len(metrics) > maxMetricDataQueries {
+		errs = append(errs, field.TooMany(
+			path, len(metrics), maxMetricDataQueries,
+		))
+	}
+	var returning *field.Path
+	for i, query := range metrics {
+		if query == nil || (query.ReturnData != nil && !*query.ReturnData) {
+			continue
+		}
+		queryPath := path.Index(i).Child("returnData")
+		if returning != nil {
+			errs = append(errs, field.Invalid(
+				queryPath, true,
+				fmt.Sprintf("only one entry may return data, %s already does", returning),
+			))
+			continue
+		}
+		returning = queryPath
+	}
+	if returning == nil {
+		errs = append(errs, field.Required(
+			path, "exactly one entry must set returnData to true",
+		))
+	}
+	return errs
+}
+
+func init() {
+	webhook := ackrtwebhook.New(
+		"v1alpha1",
+		GroupKind.Kind,
+		"validating",
+		func(mgr ctrlrt.Manager) error {
+			return ctrlrt.NewWebhookManagedBy(
+				mgr, &svcapitypes.MetricAlarm{},
+			).WithValidator(
+				&validator{},
+			).Complete()
+		},
+	)
+	if err := ackrtwebhook.RegisterWebhook(webhook); err != nil {
+		panic(fmt.Sprintf("cannot register webhook: %v", err))
+	}
+}
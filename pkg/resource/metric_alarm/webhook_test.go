@@ -0,0 +1,207 @@
+package metric_alarm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func metricQueries(n int, returning ...int) []*svcapitypes.MetricDataQuery {
+	queries := make([]*svcapitypes.MetricDataQuery, n)
+	for i := range queries {
+		queries[i] = &svcapitypes.MetricDataQuery{ReturnData: aws.Bool(false)}
+	}
+	for _, i := range returning {
+		queries[i].ReturnData = aws.Bool(true)
+	}
+	return queries
+}
+
+func TestValidateMetricAlarmSpec(t *testing.T) {
+	promql := &svcapitypes.EvaluationCriteria{
+		PromQLCriteria: &svcapitypes.AlarmPromQLCriteria{Query: aws.String("up == 0")},
+	}
+	wallClock := &svcapitypes.EvaluationWindow{
+		WallClockWindow: &svcapitypes.WallClockWindow{Timezone: aws.String("UTC")},
+	}
+
+	tests := []struct {
+		name  string
+		spec  svcapitypes.MetricAlarmSpec
+		wants []string
+	}{
+		{
+			name: "metric alarm",
+			spec: svcapitypes.MetricAlarmSpec{
+				MetricName: aws.String("CPUUtilization"),
+				Period:     aws.Int64(60),
+				Threshold:  aws.Float64(90),
+			},
+		},
+		{
+			name: "promql alarm",
+			spec: svcapitypes.MetricAlarmSpec{
+				EvaluationCriteria: promql,
+				EvaluationInterval: aws.Int64(60),
+			},
+		},
+		{
+			name: "promql alarm with metric fields",
+			spec: svcapitypes.MetricAlarmSpec{
+				EvaluationCriteria: promql,
+				EvaluationInterval: aws.Int64(60),
+				MetricName:         aws.String("CPUUtilization"),
+				Threshold:          aws.Float64(90),
+			},
+			wants: []string{"spec.metricName", "spec.threshold"},
+		},
+		{
+			name: "promql alarm without evaluation interval",
+			spec: svcapitypes.MetricAlarmSpec{
+				EvaluationCriteria: promql,
+			},
+			wants: []string{"spec.evaluationInterval"},
+		},
+		{
+			name: "evaluation interval on metric alarm",
+			spec: svcapitypes.MetricAlarmSpec{
+				MetricName:         aws.String("CPUUtilization"),
+				EvaluationInterval: aws.Int64(60),
+			},
+			wants: []string{"spec.evaluationInterval"},
+		},
+		{
+			name: "wall clock window with supported period",
+			spec: svcapitypes.MetricAlarmSpec{
+				Period:           aws.Int64(3600),
+				EvaluationWindow: wallClock,
+			},
+		},
+		{
+			name: "wall clock window with unsupported period",
+			spec: svcapitypes.MetricAlarmSpec{
+				Period:           aws.Int64(120),
+				EvaluationWindow: wallClock,
+			},
+			wants: []string{"spec.period"},
+		},
+		{
+			name: "wall clock window with unsupported query period",
+			spec: svcapitypes.MetricAlarmSpec{
+				EvaluationWindow: wallClock,
+				Metrics: []*svcapitypes.MetricDataQuery{{
+					MetricStat: &svcapitypes.MetricStat{Period: aws.Int64(900)},
+				}},
+			},
+			wants: []string{"spec.metrics[0].metricStat.period"},
+		},
+		{
+			name: "empty evaluation window",
+			spec: svcapitypes.MetricAlarmSpec{
+				EvaluationWindow: &svcapitypes.EvaluationWindow{},
+			},
+			wants: []string{"spec.evaluationWindow"},
+		},
+		{
+			name: "both evaluation windows",
+			spec: svcapitypes.MetricAlarmSpec{
+				Period: aws.Int64(60),
+				EvaluationWindow: &svcapitypes.EvaluationWindow{
+					SlidingWindow:   map[string]*string{},
+					WallClockWindow: wallClock.WallClockWindow,
+				},
+			},
+			wants: []string{"spec.evaluationWindow.slidingWindow"},
+		},
+		{
+			name: "one query returning data",
+			spec: svcapitypes.MetricAlarmSpec{Metrics: metricQueries(3, 2)},
+		},
+		{
+			name: "query returning data by default",
+			spec: svcapitypes.MetricAlarmSpec{
+				Metrics: []*svcapitypes.MetricDataQuery{{ID: aws.String("m1")}},
+			},
+		},
+		{
+			name:  "no query returning data",
+			spec:  svcapitypes.MetricAlarmSpec{Metrics: metricQueries(2)},
+			wants: []string{"spec.metrics"},
+		},
+		{
+			name:  "several queries returning data",
+			spec:  svcapitypes.MetricAlarmSpec{Metrics: metricQueries(3, 0, 1, 2)},
+			wants: []string{"spec.metrics[1].returnData", "spec.metrics[2].returnData"},
+		},
+		{
+			name:  "too many queries",
+			spec:  svcapitypes.MetricAlarmSpec{Metrics: metricQueries(21, 0)},
+			wants: []string{"spec.metrics"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateMetricAlarmSpec(&tt.spec, field.NewPath("spec"))
+			got := []string{}
+			for _, err := range errs {
+				got = append(got, err.Field)
+			}
+			if len(got) != len(tt.wants) {
+				t.Fatalf("validateMetricAlarmSpec() = %v, want errors on %v", errs, tt.wants)
+			}
+			for i := range got {
+				if got[i] != tt.wants[i] {
+					t.Errorf("error %d on %s, want %s", i, got[i], tt.wants[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	invalid := &svcapitypes.MetricAlarm{
+		Spec: svcapitypes.MetricAlarmSpec{
+			EvaluationInterval: aws.Int64(60),
+		},
+	}
+	deleting := invalid.DeepCopy()
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	changed := invalid.DeepCopy()
+	changed.Spec.Threshold = aws.Float64(90)
+
+	tests := []struct {
+		name    string
+		newObj  *svcapitypes.MetricAlarm
+		wantErr bool
+	}{
+		{
+			name:   "unchanged spec",
+			newObj: invalid.DeepCopy(),
+		},
+		{
+			name:   "being deleted",
+			newObj: deleting,
+		},
+		{
+			name:    "changed spec",
+			newObj:  changed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&validator{}).ValidateUpdate(context.TODO(), invalid, tt.newObj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -41,6 +41,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	compareStreamState(delta, a, b)
 
 	if len(a.ko.Spec.ExcludeFilters) != len(b.ko.Spec.ExcludeFilters) {
 		delta.Add("Spec.ExcludeFilters", a.ko.Spec.ExcludeFilters, b.ko.Spec.ExcludeFilters)
@@ -0,0 +1,88 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metric_stream
+
+import (
+	"context"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
+)
+
+// The values of MetricStream.Spec.State, as returned by GetMetricStream.
+const (
+	metricStreamStateRunning = "running"
+	metricStreamStateStopped = "stopped"
+)
+
+// updateCustomConditions sets the reason of the Recoverable condition when
+// a CloudWatch quota is reached. It returns true if the conditions of the
+// resource were changed.
+func (rm *resourceManager) updateCustomConditions(
+	ko *svcapitypes.MetricStream,
+	r *resource,
+	err error,
+) bool {
+	return commonutil.SetQuotaExceededReason(ko.Status.Conditions, err)
+}
+
+// updateStreamState starts or stops the metric stream according to
+// r.ko.Spec.State. PutMetricStream doesn't change the state of an existing
+// stream, so this is done with StartMetricStreams and StopMetricStreams.
+func (rm *resourceManager) updateStreamState(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.updateStreamState")
+	defer func() { exit(err) }()
+
+	names := []string{*r.ko.Spec.Name}
+	switch *r.ko.Spec.State {
+	case metricStreamStateRunning:
+		_, err = rm.sdkapi.StartMetricStreams(ctx, &svcsdk.StartMetricStreamsInput{Names: names})
+		rm.metrics.RecordAPICall("UPDATE", "StartMetricStreams", err)
+	case metricStreamStateStopped:
+		_, err = rm.sdkapi.StopMetricStreams(ctx, &svcsdk.StopMetricStreamsInput{Names: names})
+		rm.metrics.RecordAPICall("UPDATE", "StopMetricStreams", err)
+	default:
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"invalid state %q, must be %q or %q",
+			*r.ko.Spec.State, metricStreamStateRunning, metricStreamStateStopped,
+		))
+	}
+	return err
+}
+
+// compareStreamState adds a difference at Spec.State only when the desired
+// state is set, so that streams without a desired state are left in whatever
+// state they are in.
+func compareStreamState(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if a.ko.Spec.State == nil {
+		return
+	}
+	if b.ko.Spec.State == nil || *a.ko.Spec.State != *b.ko.Spec.State {
+		delta.Add("Spec.State", a.ko.Spec.State, b.ko.Spec.State)
+	}
+}
@@ -0,0 +1,52 @@
+package metric_stream
+
+import (
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func TestCompareStreamState(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  *string
+		latest   *string
+		wantDiff bool
+	}{
+		{
+			name:   "desired state not set",
+			latest: aws.String("running"),
+		},
+		{
+			name:    "same state",
+			desired: aws.String("stopped"),
+			latest:  aws.String("stopped"),
+		},
+		{
+			name:     "different state",
+			desired:  aws.String("stopped"),
+			latest:   aws.String("running"),
+			wantDiff: true,
+		},
+		{
+			name:     "state not observed",
+			desired:  aws.String("running"),
+			wantDiff: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &resource{&svcapitypes.MetricStream{Spec: svcapitypes.MetricStreamSpec{State: tt.desired}}}
+			b := &resource{&svcapitypes.MetricStream{Spec: svcapitypes.MetricStreamSpec{State: tt.latest}}}
+			delta := ackcompare.NewDelta()
+			compareStreamState(delta, a, b)
+			if got := delta.DifferentAt("Spec.State"); got != tt.wantDiff {
+				t.Errorf("compareStreamState() difference = %v, want %v", got, tt.wantDiff)
+			}
+		})
+	}
+}
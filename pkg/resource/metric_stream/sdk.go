@@ -36,6 +36,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/cloudwatch-controller/pkg/drift"
+	commonutil "github.com/aws-controllers-k8s/cloudwatch-controller/pkg/util"
 )
 
 // Hack to avoid import errors during build...
@@ -78,6 +80,7 @@ func (rm *resourceManager) sdkFind(
 	var resp *svcsdk.GetMetricStreamOutput
 	resp, err = rm.sdkapi.GetMetricStream(ctx, input)
 	rm.metrics.RecordAPICall("READ_ONE", "GetMetricStream", err)
+	err = commonutil.RequeueOnAPIError(err)
 	if err != nil {
 		var awsErr smithy.APIError
 		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException" {
@@ -97,6 +100,11 @@ func (rm *resourceManager) sdkFind(
 		arn := ackv1alpha1.AWSResourceName(*resp.Arn)
 		ko.Status.ACKResourceMetadata.ARN = &arn
 	}
+	if resp.CreationDate != nil {
+		ko.Status.CreationDate = &metav1.Time{Time: *resp.CreationDate}
+	} else {
+		ko.Status.CreationDate = nil
+	}
 	if resp.ExcludeFilters != nil {
 		f2 := []*svcapitypes.MetricStreamFilter{}
 		for _, f2iter := range resp.ExcludeFilters {
@@ -139,6 +147,11 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ko.Spec.IncludeLinkedAccountsMetrics = nil
 	}
+	if resp.LastUpdateDate != nil {
+		ko.Status.LastUpdateDate = &metav1.Time{Time: *resp.LastUpdateDate}
+	} else {
+		ko.Status.LastUpdateDate = nil
+	}
 	if resp.Name != nil {
 		ko.Spec.Name = resp.Name
 	} else {
@@ -154,6 +167,11 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ko.Spec.RoleARN = nil
 	}
+	if resp.State != nil {
+		ko.Spec.State = resp.State
+	} else {
+		ko.Spec.State = nil
+	}
 	if resp.StatisticsConfigurations != nil {
 		f11 := []*svcapitypes.MetricStreamStatisticsConfiguration{}
 		for _, f11iter := range resp.StatisticsConfigurations {
@@ -182,6 +200,14 @@ func (rm *resourceManager) sdkFind(
 		ko.Spec.StatisticsConfigurations = nil
 	}
 
+	ko.Status.StreamState = resp.State
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+	drift.Observe(&resourceDescriptor{}, &resource{ko})
 	rm.setStatusDefaults(ko)
 	return &resource{ko}, nil
 }
@@ -231,6 +257,7 @@ func (rm *resourceManager) sdkCreate(
 	_ = resp
 	resp, err = rm.sdkapi.PutMetricStream(ctx, input)
 	rm.metrics.RecordAPICall("CREATE", "PutMetricStream", err)
+	err = commonutil.RequeueOnAPIError(err)
 	if err != nil {
 		return nil, err
 	}
@@ -246,6 +273,12 @@ func (rm *resourceManager) sdkCreate(
 		ko.Status.ACKResourceMetadata.ARN = &arn
 	}
 
+	// PutMetricStream always creates the stream in the running state.
+	if ko.Spec.State != nil && *ko.Spec.State != metricStreamStateRunning {
+		if err = rm.updateStreamState(ctx, &resource{ko}); err != nil {
+			return nil, err
+		}
+	}
 	rm.setStatusDefaults(ko)
 	return &resource{ko}, nil
 }
@@ -357,6 +390,25 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	drift.Forget(&resourceDescriptor{}, desired)
+	if delta.DifferentAt("Spec.Tags") {
+		if err = commonutil.SyncTags(
+			ctx, rm.sdkapi, rm.metrics, latest.ko.Status.ACKResourceMetadata,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+			rm.cfg.ResourceTagKeys, convertToOrderedACKTags,
+			syncAWSTags, ignoreSystemTags,
+		); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.State") {
+		if err = rm.updateStreamState(ctx, desired); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentExcept("Spec.Tags", "Spec.State") {
+		return desired, nil
+	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
 		return nil, err
@@ -366,6 +418,7 @@ func (rm *resourceManager) sdkUpdate(
 	_ = resp
 	resp, err = rm.sdkapi.PutMetricStream(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "PutMetricStream", err)
+	err = commonutil.RequeueOnAPIError(err)
 	if err != nil {
 		return nil, err
 	}
@@ -490,6 +543,7 @@ func (rm *resourceManager) sdkDelete(
 	defer func() {
 		exit(err)
 	}()
+	drift.Forget(&resourceDescriptor{}, r)
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -498,6 +552,7 @@ func (rm *resourceManager) sdkDelete(
 	_ = resp
 	resp, err = rm.sdkapi.DeleteMetricStream(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "DeleteMetricStream", err)
+	err = commonutil.RequeueOnAPIError(err)
 	return nil, err
 }
 
@@ -605,9 +660,9 @@ func (rm *resourceManager) updateConditions(
 			recoverableCondition.Message = nil
 		}
 	}
-	// Required to avoid the "declared but not used" error in the default case
-	_ = syncCondition
-	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil {
+	// custom update conditions
+	customUpdate := rm.updateCustomConditions(ko, r, err)
+	if terminalCondition != nil || recoverableCondition != nil || syncCondition != nil || customUpdate {
 		return &resource{ko}, true // updated
 	}
 	return nil, false // not updated
@@ -617,6 +672,20 @@ func (rm *resourceManager) updateConditions(
 // and if the exception indicates that it is a Terminal exception
 // 'Terminal' exception are specified in generator configuration
 func (rm *resourceManager) terminalAWSError(err error) bool {
-	// No terminal_errors specified for this resource in generator config
-	return false
+	if err == nil {
+		return false
+	}
+
+	var terminalErr smithy.APIError
+	if !errors.As(err, &terminalErr) {
+		return false
+	}
+	switch terminalErr.ErrorCode() {
+	case "InvalidParameterValue",
+		"InvalidParameterCombination",
+		"MissingParameter":
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,227 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package metric_stream
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	ackrtwebhook "github.com/aws-controllers-k8s/runtime/pkg/webhook"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+const (
+	// maxFilterNames is the number of namespace and metric names that the
+	// filters of a metric stream can list in total.
+	maxFilterNames = 1000
+	// maxStatisticsConfigurations is the number of entries PutMetricStream
+	// accepts in StatisticsConfigurations.
+	maxStatisticsConfigurations = 100
+)
+
+// percentileStatisticRegex matches the percentile statistics, such as p95 or
+// p99.9, which are the only additional statistics an OpenTelemetry stream
+// can send.
+var percentileStatisticRegex = regexp.MustCompile(`^p(\d{1,2}(\.\d+)?|100)$`)
+
+// +kubebuilder:webhook:path=/validate-cloudwatch-services-k8s-aws-v1alpha1-metricstream,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloudwatch.services.k8s.aws,resources=metricstreams,verbs=create;update,versions=v1alpha1,name=vmetricstream.cloudwatch.services.k8s.aws,admissionReviewVersions=v1
+
+// validator rejects MetricStreams whose spec PutMetricStream would refuse,
+// and changes to the fields that identify an existing stream.
+type validator struct{}
+
+// ValidateCreate validates the spec of a MetricStream being created.
+func (v *validator) ValidateCreate(
+	ctx context.Context,
+	obj *svcapitypes.MetricStream,
+) (admission.Warnings, error) {
+	return nil, invalidMetricStream(obj, validateMetricStreamSpec(
+		&obj.Spec, field.NewPath("spec"),
+	))
+}
+
+// ValidateUpdate validates the spec of a MetricStream being updated. Updates
+// leaving the spec unchanged, such as the status and finalizer updates of
+// the controller, and updates of a MetricStream being deleted are allowed.
+func (v *validator) ValidateUpdate(
+	ctx context.Context,
+	oldObj *svcapitypes.MetricStream,
+	newObj *svcapitypes.MetricStream,
+) (admission.Warnings, error) {
+	if newObj.DeletionTimestamp != nil || equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	path := field.NewPath("spec")
+	errs := validateMetricStreamSpec(&newObj.Spec, path)
+	errs = append(errs, validateImmutableFields(&oldObj.Spec, &newObj.Spec, path)...)
+	return nil, invalidMetricStream(newObj, errs)
+}
+
+// ValidateDelete allows every MetricStream to be deleted.
+func (v *validator) ValidateDelete(
+	ctx context.Context,
+	obj *svcapitypes.MetricStream,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// invalidMetricStream returns an Invalid API error listing the supplied
+// errors, or nil if there is none.
+func invalidMetricStream(ko *svcapitypes.MetricStream, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupKind.Group, Kind: GroupKind.Kind},
+		ko.Name,
+		errs,
+	)
+}
+
+// validateMetricStreamSpec checks the rules PutMetricStream enforces on the
+// filters, output format and statistics of the stream.
+func validateMetricStreamSpec(
+	spec *svcapitypes.MetricStreamSpec,
+	path *field.Path,
+) field.ErrorList {
+	errs := field.ErrorList{}
+	if len(spec.IncludeFilters) > 0 && len(spec.ExcludeFilters) > 0 {
+		errs = append(errs, field.Forbidden(
+			path.Child("excludeFilters"),
+			"may not be set together with includeFilters",
+		))
+	}
+	errs = append(errs, validateFilters(spec.IncludeFilters, path.Child("includeFilters"))...)
+	errs = append(errs, validateFilters(spec.ExcludeFilters, path.Child("excludeFilters"))...)
+
+	if spec.OutputFormat == nil {
+		return errs
+	}
+	outputFormat := svcsdktypes.MetricStreamOutputFormat(*spec.OutputFormat)
+	supported := []string{}
+	for _, f := range outputFormat.Values() {
+		supported = append(supported, string(f))
+	}
+	switch outputFormat {
+	case svcsdktypes.MetricStreamOutputFormatJson:
+	case svcsdktypes.MetricStreamOutputFormatOpenTelemetry07,
+		svcsdktypes.MetricStreamOutputFormatOpenTelemetry10:
+		errs = append(errs, validateOpenTelemetryStatistics(
+			spec.StatisticsConfigurations, path.Child("statisticsConfigurations"),
+		)...)
+	default:
+		errs = append(errs, field.NotSupported(
+			path.Child("outputFormat"), *spec.OutputFormat, supported,
+		))
+	}
+	if len(spec.StatisticsConfigurations) > maxStatisticsConfigurations {
+		errs = append(errs, field.TooMany(
+			path.Child("statisticsConfigurations"),
+			len(spec.StatisticsConfigurations), maxStatisticsConfigurations,
+		))
+	}
+	return errs
+}
+
+// validateFilters checks that the filters list at most maxFilterNames
+// namespace and metric names in total.
+func validateFilters(
+	filters []*svcapitypes.MetricStreamFilter,
+	path *field.Path,
+) field.ErrorList {
+	names := 0
+	for _, filter := range filters {
+		if filter == nil {
+			continue
+		}
+		if filter.Namespace != nil {
+			names++
+		}
+		names += len(filter.MetricNames)
+	}
+	if names <= maxFilterNames {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(path, fmt.Sprintf(
+		"filters may list at most %d namespace and metric names in total, found %d",
+		maxFilterNames, names,
+	))}
+}
+
+// validateOpenTelemetryStatistics checks that the statistics configurations
+// of an OpenTelemetry stream only request percentile statistics.
+func validateOpenTelemetryStatistics(
+	configs []*svcapitypes.MetricStreamStatisticsConfiguration,
+	path *field.Path,
+) field.ErrorList {
+	errs := field.ErrorList{}
+	for i, config := range configs {
+		if config == nil {
+			continue
+		}
+		for j, stat := range config.AdditionalStatistics {
+			if stat == nil || percentileStatisticRegex.MatchString(*stat) {
+				continue
+			}
+			errs = append(errs, field.Invalid(
+				path.Index(i).Child("additionalStatistics").Index(j), *stat,
+				"only percentile statistics such as p95 or p99.9 can be streamed in an OpenTelemetry output format",
+			))
+		}
+	}
+	return errs
+}
+
+// validateImmutableFields rejects changes to the name of the stream, which
+// PutMetricStream would take as a request for a new stream while the old one
+// is left behind.
+func validateImmutableFields(
+	oldSpec *svcapitypes.MetricStreamSpec,
+	newSpec *svcapitypes.MetricStreamSpec,
+	path *field.Path,
+) field.ErrorList {
+	if oldSpec.Name == nil || newSpec.Name == nil || *oldSpec.Name == *newSpec.Name {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(
+		path.Child("name"), "field is immutable",
+	)}
+}
+
+func init() {
+	webhook := ackrtwebhook.New(
+		"v1alpha1",
+		GroupKind.Kind,
+		"validating",
+		func(mgr ctrlrt.Manager) error {
+			return ctrlrt.NewWebhookManagedBy(
+				mgr, &svcapitypes.MetricStream{},
+			).WithValidator(
+				&validator{},
+			).Complete()
+		},
+	)
+	if err := ackrtwebhook.RegisterWebhook(webhook); err != nil {
+		panic(fmt.Sprintf("cannot register webhook: %v", err))
+	}
+}
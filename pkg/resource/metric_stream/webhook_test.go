@@ -0,0 +1,211 @@
+package metric_stream
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// filterWithNames returns a filter on a namespace listing n metric names.
+func filterWithNames(n int) *svcapitypes.MetricStreamFilter {
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("Metric%d", i)
+	}
+	return &svcapitypes.MetricStreamFilter{
+		Namespace:   aws.String("AWS/EC2"),
+		MetricNames: aws.StringSlice(names),
+	}
+}
+
+func statistics(stats ...string) []*svcapitypes.MetricStreamStatisticsConfiguration {
+	return []*svcapitypes.MetricStreamStatisticsConfiguration{{
+		AdditionalStatistics: aws.StringSlice(stats),
+		IncludeMetrics: []*svcapitypes.MetricStreamStatisticsMetric{{
+			Namespace:  aws.String("AWS/EC2"),
+			MetricName: aws.String("CPUUtilization"),
+		}},
+	}}
+}
+
+func TestValidateMetricStreamSpec(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  svcapitypes.MetricStreamSpec
+		wants []string
+	}{
+		{
+			name: "include filters",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat:   aws.String("json"),
+				IncludeFilters: []*svcapitypes.MetricStreamFilter{filterWithNames(2)},
+			},
+		},
+		{
+			name: "include and exclude filters",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat:   aws.String("json"),
+				IncludeFilters: []*svcapitypes.MetricStreamFilter{filterWithNames(2)},
+				ExcludeFilters: []*svcapitypes.MetricStreamFilter{filterWithNames(1)},
+			},
+			wants: []string{"spec.excludeFilters"},
+		},
+		{
+			name: "filters at the name limit",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat: aws.String("json"),
+				ExcludeFilters: []*svcapitypes.MetricStreamFilter{
+					filterWithNames(499), filterWithNames(499),
+				},
+			},
+		},
+		{
+			name: "filters over the name limit",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat: aws.String("json"),
+				ExcludeFilters: []*svcapitypes.MetricStreamFilter{
+					filterWithNames(499), filterWithNames(500),
+				},
+			},
+			wants: []string{"spec.excludeFilters"},
+		},
+		{
+			name:  "unknown output format",
+			spec:  svcapitypes.MetricStreamSpec{OutputFormat: aws.String("csv")},
+			wants: []string{"spec.outputFormat"},
+		},
+		{
+			name: "json statistics",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat:             aws.String("json"),
+				StatisticsConfigurations: statistics("p99", "TM(10%:90%)", "IQM"),
+			},
+		},
+		{
+			name: "opentelemetry percentile statistics",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat:             aws.String("opentelemetry1.0"),
+				StatisticsConfigurations: statistics("p95", "p99.9", "p100"),
+			},
+		},
+		{
+			name: "opentelemetry non-percentile statistics",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat:             aws.String("opentelemetry0.7"),
+				StatisticsConfigurations: statistics("p99", "TM(10%:90%)"),
+			},
+			wants: []string{"spec.statisticsConfigurations[0].additionalStatistics[1]"},
+		},
+		{
+			name: "too many statistics configurations",
+			spec: svcapitypes.MetricStreamSpec{
+				OutputFormat: aws.String("json"),
+				StatisticsConfigurations: make(
+					[]*svcapitypes.MetricStreamStatisticsConfiguration, 101,
+				),
+			},
+			wants: []string{"spec.statisticsConfigurations"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateMetricStreamSpec(&tt.spec, field.NewPath("spec"))
+			assertErrorFields(t, errs, tt.wants)
+		})
+	}
+}
+
+func TestValidateImmutableFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldName string
+		newName string
+		wants   []string
+	}{
+		{
+			name:    "unchanged name",
+			oldName: "stream",
+			newName: "stream",
+		},
+		{
+			name:    "changed name",
+			oldName: "stream",
+			newName: "other-stream",
+			wants:   []string{"spec.name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateImmutableFields(
+				&svcapitypes.MetricStreamSpec{Name: aws.String(tt.oldName)},
+				&svcapitypes.MetricStreamSpec{Name: aws.String(tt.newName)},
+				field.NewPath("spec"),
+			)
+			assertErrorFields(t, errs, tt.wants)
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	invalid := &svcapitypes.MetricStream{
+		Spec: svcapitypes.MetricStreamSpec{
+			Name:           aws.String("stream"),
+			IncludeFilters: []*svcapitypes.MetricStreamFilter{filterWithNames(1)},
+			ExcludeFilters: []*svcapitypes.MetricStreamFilter{filterWithNames(1)},
+		},
+	}
+	deleting := invalid.DeepCopy()
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	changed := invalid.DeepCopy()
+	changed.Spec.OutputFormat = aws.String("json")
+
+	tests := []struct {
+		name    string
+		newObj  *svcapitypes.MetricStream
+		wantErr bool
+	}{
+		{
+			name:   "unchanged spec",
+			newObj: invalid.DeepCopy(),
+		},
+		{
+			name:   "being deleted",
+			newObj: deleting,
+		},
+		{
+			name:    "changed spec",
+			newObj:  changed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&validator{}).ValidateUpdate(context.TODO(), invalid, tt.newObj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func assertErrorFields(t *testing.T, errs field.ErrorList, wants []string) {
+	t.Helper()
+	if len(errs) != len(wants) {
+		t.Fatalf("got errors %v, want errors on %v", errs, wants)
+	}
+	for i, err := range errs {
+		if err.Field != wants[i] {
+			t.Errorf("error %d on %s, want %s", i, err.Field, wants[i])
+		}
+	}
+}
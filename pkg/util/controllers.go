@@ -0,0 +1,108 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"sync"
+
+	"github.com/go-logr/logr"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ControllerRecorder is a manager recording the controllers added to it by
+// the kind they reconcile. The service controller binds a controller for
+// each reconciled kind without returning it, so a ControllerRecorder is
+// passed to BindControllerManager to add watches to those controllers.
+type ControllerRecorder struct {
+	ctrlrt.Manager
+
+	mu          sync.Mutex
+	controllers map[string]controller.Controller
+}
+
+// NewControllerRecorder returns a ControllerRecorder adding the controllers
+// to the supplied manager.
+func NewControllerRecorder(mgr ctrlrt.Manager) *ControllerRecorder {
+	return &ControllerRecorder{
+		Manager:     mgr,
+		controllers: map[string]controller.Controller{},
+	}
+}
+
+// GetLogger returns the logger of the manager, recording the kind the
+// controller builder adds to the logger of each controller.
+func (m *ControllerRecorder) GetLogger() logr.Logger {
+	log := m.Manager.GetLogger()
+	if log.GetSink() == nil {
+		return log
+	}
+	return logr.New(&kindSink{LogSink: log.GetSink()})
+}
+
+// Add records the supplied runnable when it is a controller of a kind, and
+// adds it to the manager.
+func (m *ControllerRecorder) Add(r manager.Runnable) error {
+	if c, ok := r.(controller.Controller); ok {
+		if sink, ok := c.GetLogger().GetSink().(*kindSink); ok && sink.kind != "" {
+			m.mu.Lock()
+			m.controllers[sink.kind] = c
+			m.mu.Unlock()
+		}
+	}
+	return m.Manager.Add(r)
+}
+
+// Controller returns the controller reconciling the supplied kind, or nil if
+// the kind isn't reconciled.
+func (m *ControllerRecorder) Controller(kind string) controller.Controller {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.controllers[kind]
+}
+
+// kindSink is a LogSink recording the controllerKind value of the logger.
+type kindSink struct {
+	logr.LogSink
+	kind string
+}
+
+// WithValues returns a kindSink with the supplied values, recording the
+// controllerKind value.
+func (s *kindSink) WithValues(keysAndValues ...any) logr.LogSink {
+	kind := s.kind
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if key, ok := keysAndValues[i].(string); ok && key == "controllerKind" {
+			if v, ok := keysAndValues[i+1].(string); ok {
+				kind = v
+			}
+		}
+	}
+	return &kindSink{LogSink: s.LogSink.WithValues(keysAndValues...), kind: kind}
+}
+
+// WithName returns a kindSink with the supplied name.
+func (s *kindSink) WithName(name string) logr.LogSink {
+	return &kindSink{LogSink: s.LogSink.WithName(name), kind: s.kind}
+}
+
+// WithCallDepth returns a kindSink with the supplied call depth, when the
+// recorded sink supports it.
+func (s *kindSink) WithCallDepth(depth int) logr.LogSink {
+	if sink, ok := s.LogSink.(logr.CallDepthLogSink); ok {
+		return &kindSink{LogSink: sink.WithCallDepth(depth), kind: s.kind}
+	}
+	return s
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// testManager is a manager recording the added runnables.
+type testManager struct {
+	ctrlrt.Manager
+	added []manager.Runnable
+}
+
+func (m *testManager) GetLogger() logr.Logger {
+	return funcr.New(func(prefix, args string) {}, funcr.Options{})
+}
+
+func (m *testManager) Add(r manager.Runnable) error {
+	m.added = append(m.added, r)
+	return nil
+}
+
+func TestControllerRecorder(t *testing.T) {
+	mgr := &testManager{}
+	recorder := NewControllerRecorder(mgr)
+
+	newController := func(name string, values ...any) controller.Controller {
+		log := recorder.GetLogger().WithValues("controller", name).WithValues(values...)
+		c, err := controller.NewUnmanaged(name, controller.Options{
+			Reconciler:     reconcile.Func(nil),
+			LogConstructor: func(*reconcile.Request) logr.Logger { return log },
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := recorder.Add(c); err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	dashboard := newController("dashboard", "controllerGroup", "cloudwatch.services.k8s.aws", "controllerKind", "Dashboard")
+	newController("named")
+
+	if got := recorder.Controller("Dashboard"); got != dashboard {
+		t.Errorf("Controller(Dashboard) = %v, want the dashboard controller", got)
+	}
+	if got := recorder.Controller("MetricAlarm"); got != nil {
+		t.Errorf("Controller(MetricAlarm) = %v, want nil", got)
+	}
+	if len(mgr.added) != 2 {
+		t.Errorf("manager runnables = %d, want 2", len(mgr.added))
+	}
+}
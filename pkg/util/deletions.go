@@ -0,0 +1,196 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	smithy "github.com/aws/smithy-go"
+)
+
+const (
+	// DeletionWindow is how long a deletion queued by a DeletionCoalescer
+	// waits for others before being sent.
+	DeletionWindow = 2 * time.Second
+	// MaxDeletionBatchSize is the maximum number of names in a DeleteAlarms
+	// or DeleteDashboards request.
+	MaxDeletionBatchSize = 100
+	// deletionTimeout is how long a request is expected to complete in.
+	// The names of a request that did not complete by then are queued
+	// again, in case the reconciler sending it never reported its result.
+	deletionTimeout = time.Minute
+	// queuedDeletionTTL is how long a queued name stays queued without its
+	// reconciler asking for its deletion again. A reconciler is requeued
+	// every DeletionWindow while its deletion is queued, so a name it didn't
+	// ask for again by then belongs to a resource that is not being deleted
+	// anymore, e.g. because its deletion policy changed to retain or its
+	// finalizer was removed.
+	queuedDeletionTTL = 3 * DeletionWindow
+)
+
+// errDeletionQueued is returned, wrapped to requeue the resource, while its
+// deletion waits to be sent with others.
+var errDeletionQueued = errors.New("deletion queued to be sent with other deletions")
+
+// queuedDeletion is a name waiting to be deleted, since when, and when its
+// reconciler last asked for its deletion.
+type queuedDeletion struct {
+	name      string
+	since     time.Time
+	confirmed time.Time
+}
+
+// DeletionCoalescer groups the deletions of the resources of one kind, in
+// one account and region, into requests of up to MaxDeletionBatchSize names.
+//
+// It does not send the requests itself: the reconciler of a resource calls
+// Next for the names to send, which queues the resource and requeues it
+// until the oldest queued deletion waited for DeletionWindow. The request is
+// then sent by whichever reconciler comes next, with every queued name whose
+// reconciler asked for it again within queuedDeletionTTL, and its result is
+// reported with Done. The other resources of the request
+// collect their result the next time they are reconciled: a deleted
+// resource is not found anymore, and a failed one gets the error of the
+// request. Since CloudWatch deletes none of the names of a request when one
+// of them fails, the names of a request that failed for another reason
+// than throttling or a quota are sent alone the next time.
+type DeletionCoalescer struct {
+	now func() time.Time
+
+	mu     sync.Mutex
+	queued []queuedDeletion
+	// sending holds, by name, when the request including it was sent.
+	sending map[string]time.Time
+	// failed holds, by name, the error of the failed request including it.
+	failed map[string]error
+	// alone holds the names to send alone.
+	alone map[string]bool
+}
+
+// NewDeletionCoalescer returns an empty DeletionCoalescer.
+func NewDeletionCoalescer() *DeletionCoalescer {
+	return &DeletionCoalescer{
+		now:     time.Now,
+		sending: map[string]time.Time{},
+		failed:  map[string]error{},
+		alone:   map[string]bool{},
+	}
+}
+
+// Next returns the names to delete in the request sent by the reconciler of
+// the resource with the supplied name. The supplied name comes first. It
+// returns an error requeueing the resource while its deletion is queued or
+// sent by another reconciler, and the error of the last request including
+// the name if it failed.
+func (c *DeletionCoalescer) Next(name string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if err, ok := c.failed[name]; ok {
+		delete(c.failed, name)
+		return nil, err
+	}
+	if c.alone[name] {
+		delete(c.alone, name)
+		c.sending[name] = now
+		return []string{name}, nil
+	}
+	if since, ok := c.sending[name]; ok {
+		if now.Sub(since) < deletionTimeout {
+			return nil, ackrequeue.NeededAfter(errDeletionQueued, DeletionWindow)
+		}
+		delete(c.sending, name)
+	}
+	c.queued = slices.DeleteFunc(c.queued, func(q queuedDeletion) bool {
+		return q.name != name && now.Sub(q.confirmed) > queuedDeletionTTL
+	})
+	if i := slices.IndexFunc(c.queued, func(q queuedDeletion) bool { return q.name == name }); i >= 0 {
+		c.queued[i].confirmed = now
+	} else {
+		c.queued = append(c.queued, queuedDeletion{name: name, since: now, confirmed: now})
+	}
+	if len(c.queued) < MaxDeletionBatchSize && now.Sub(c.queued[0].since) < DeletionWindow {
+		return nil, ackrequeue.NeededAfter(errDeletionQueued, DeletionWindow)
+	}
+
+	names := []string{name}
+	c.queued = slices.DeleteFunc(c.queued, func(q queuedDeletion) bool { return q.name == name })
+	n := min(len(c.queued), MaxDeletionBatchSize-1)
+	for _, q := range c.queued[:n] {
+		names = append(names, q.name)
+	}
+	c.queued = slices.Delete(c.queued, 0, n)
+	for _, name := range names {
+		c.sending[name] = now
+	}
+	return names, nil
+}
+
+// Done records the result of the request deleting the supplied names,
+// returned by Next, and returns the error to report for the resource whose
+// reconciler sent it.
+func (c *DeletionCoalescer) Done(names []string, err error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, name := range names {
+		delete(c.sending, name)
+	}
+	if err == nil || len(names) == 1 {
+		return err
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) &&
+		!isThrottlingErrorCode(apiErr.ErrorCode()) && !isQuotaErrorCode(apiErr.ErrorCode()) {
+		for _, name := range names {
+			c.alone[name] = true
+		}
+		return ackrequeue.NeededAfter(
+			fmt.Errorf("deletion sent with %d others failed, sending it alone: %w", len(names)-1, err),
+			DeletionWindow,
+		)
+	}
+	for _, name := range names[1:] {
+		c.failed[name] = RequeueOnAPIError(err)
+	}
+	return err
+}
+
+// DeletionCoalescers holds a DeletionCoalescer by key, typically the account
+// and region of the resources.
+type DeletionCoalescers struct {
+	mu         sync.Mutex
+	coalescers map[string]*DeletionCoalescer
+}
+
+// For returns the DeletionCoalescer with the supplied key, created on first
+// use.
+func (c *DeletionCoalescers) For(key string) *DeletionCoalescer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.coalescers == nil {
+		c.coalescers = map[string]*DeletionCoalescer{}
+	}
+	coalescer, ok := c.coalescers[key]
+	if !ok {
+		coalescer = NewDeletionCoalescer()
+		c.coalescers[key] = coalescer
+	}
+	return coalescer
+}
@@ -0,0 +1,146 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	smithy "github.com/aws/smithy-go"
+)
+
+func newTestCoalescer() (*DeletionCoalescer, *time.Time) {
+	c := NewDeletionCoalescer()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	return c, &now
+}
+
+func assertQueued(t *testing.T, c *DeletionCoalescer, name string) {
+	t.Helper()
+	names, err := c.Next(name)
+	if !errors.Is(err, errDeletionQueued) || names != nil {
+		t.Errorf("Next(%q) = %v, %v, want the deletion queued", name, names, err)
+	}
+}
+
+func assertNext(t *testing.T, c *DeletionCoalescer, name string, want []string) {
+	t.Helper()
+	names, err := c.Next(name)
+	if err != nil || !reflect.DeepEqual(names, want) {
+		t.Errorf("Next(%q) = %v, %v, want %v", name, names, err, want)
+	}
+}
+
+func TestDeletionCoalescerWindow(t *testing.T) {
+	c, now := newTestCoalescer()
+	start := *now
+	assertQueued(t, c, "a")
+	*now = start.Add(DeletionWindow / 2)
+	assertQueued(t, c, "b")
+	assertQueued(t, c, "a")
+	*now = start.Add(DeletionWindow)
+	assertNext(t, c, "b", []string{"b", "a"})
+	// Sent by the reconciler of b.
+	assertQueued(t, c, "a")
+	if err := c.Done([]string{"b", "a"}, nil); err != nil {
+		t.Errorf("Done() = %v, want nil", err)
+	}
+	// A request that never completes is sent again.
+	assertQueued(t, c, "c")
+	*now = now.Add(DeletionWindow)
+	assertNext(t, c, "c", []string{"c"})
+	*now = now.Add(deletionTimeout)
+	assertQueued(t, c, "c")
+}
+
+func TestDeletionCoalescerStale(t *testing.T) {
+	c, now := newTestCoalescer()
+	start := *now
+	// The deletion of a is not asked for again, e.g. because its deletion
+	// policy changed to retain, so it is not sent with b.
+	assertQueued(t, c, "a")
+	*now = start.Add(queuedDeletionTTL + time.Second)
+	assertQueued(t, c, "b")
+	*now = now.Add(DeletionWindow)
+	assertNext(t, c, "b", []string{"b"})
+	// A resource recreated with the same name and deleted again is queued
+	// anew.
+	assertQueued(t, c, "a")
+}
+
+func TestDeletionCoalescerBatchSize(t *testing.T) {
+	c, _ := newTestCoalescer()
+	var want []string
+	for i := range MaxDeletionBatchSize + 1 {
+		name := fmt.Sprintf("name-%03d", i)
+		want = append(want, name)
+		if i < MaxDeletionBatchSize-1 {
+			assertQueued(t, c, name)
+		}
+	}
+	last := want[MaxDeletionBatchSize-1]
+	assertNext(t, c, last, append([]string{last}, want[:MaxDeletionBatchSize-1]...))
+	assertQueued(t, c, want[MaxDeletionBatchSize])
+}
+
+func TestDeletionCoalescerDone(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		// wantAlone is true when the names are sent alone the next time,
+		// false when the others get the error.
+		wantAlone bool
+	}{
+		{
+			name: "throttling",
+			err:  apiError("Throttling"),
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("connection reset"),
+		},
+		{
+			name:      "alarm not found",
+			err:       apiError("ResourceNotFound"),
+			wantAlone: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, now := newTestCoalescer()
+			assertQueued(t, c, "a")
+			*now = now.Add(DeletionWindow)
+			assertNext(t, c, "b", []string{"b", "a"})
+			err := c.Done([]string{"b", "a"}, tt.err)
+
+			if tt.wantAlone {
+				var requeue *ackrequeue.RequeueNeededAfter
+				if !errors.As(err, &requeue) || !errors.Is(err, tt.err) {
+					t.Errorf("Done() = %v, want a requeue wrapping %v", err, tt.err)
+				}
+				assertNext(t, c, "a", []string{"a"})
+				assertNext(t, c, "b", []string{"b"})
+				return
+			}
+			if err != tt.err {
+				t.Errorf("Done() = %v, want %v", err, tt.err)
+			}
+			names, err := c.Next("a")
+			if names != nil || !errors.Is(err, tt.err) {
+				t.Errorf("Next(a) = %v, %v, want %v", names, err, tt.err)
+			}
+			var apiErr smithy.APIError
+			if errors.As(tt.err, &apiErr) {
+				var requeue *ackrequeue.RequeueNeededAfter
+				if !errors.As(err, &requeue) {
+					t.Errorf("Next(a) = %v, want a requeue", err)
+				}
+			}
+			assertQueued(t, c, "a")
+		})
+	}
+}
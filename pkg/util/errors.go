@@ -0,0 +1,116 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	smithy "github.com/aws/smithy-go"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ThrottlingRequeueAfter is the shortest delay before a resource is
+	// reconciled again after CloudWatch throttled a request. A random delay
+	// of up to the same duration is added to it, so that the resources
+	// throttled together don't retry together.
+	ThrottlingRequeueAfter = 10 * time.Second
+	// QuotaRequeueAfter is the delay before a resource is reconciled again
+	// after a request failed because a CloudWatch quota is reached. Quotas
+	// are only freed by deleting resources or by a quota increase, so there
+	// is no point in retrying sooner.
+	QuotaRequeueAfter = 5 * time.Minute
+)
+
+// ConditionReasonQuotaExceeded is the reason of the Recoverable condition
+// while a request fails because a CloudWatch quota is reached.
+const ConditionReasonQuotaExceeded = "QuotaExceeded"
+
+// isThrottlingErrorCode returns true for the error codes CloudWatch returns
+// when it throttles a request.
+func isThrottlingErrorCode(code string) bool {
+	switch code {
+	case "Throttling", "ThrottlingException", "TooManyRequestsException":
+		return true
+	}
+	return false
+}
+
+// isQuotaErrorCode returns true for the error codes CloudWatch returns when
+// a request would exceed a quota.
+func isQuotaErrorCode(code string) bool {
+	switch code {
+	case "LimitExceeded", "LimitExceededException":
+		return true
+	}
+	return false
+}
+
+// RequeueOnAPIError returns the error of a CloudWatch API call wrapped so
+// that the reconciler requeues the resource after a delay suited to it:
+// ThrottlingRequeueAfter plus jitter for throttling errors, and
+// QuotaRequeueAfter for quota errors. Any other error is returned unchanged.
+// The wrapped error still unwraps to the API error, so that it is classified
+// and reported in the conditions as before.
+func RequeueOnAPIError(err error) error {
+	var apiErr smithy.APIError
+	if err == nil || !errors.As(err, &apiErr) {
+		return err
+	}
+	switch {
+	case isThrottlingErrorCode(apiErr.ErrorCode()):
+		jitter := rand.N(ThrottlingRequeueAfter)
+		return ackrequeue.NeededAfter(err, ThrottlingRequeueAfter+jitter)
+	case isQuotaErrorCode(apiErr.ErrorCode()):
+		return ackrequeue.NeededAfter(err, QuotaRequeueAfter)
+	}
+	return err
+}
+
+// SetQuotaExceededReason sets the reason of the Recoverable condition to
+// ConditionReasonQuotaExceeded when the supplied error is a quota error, and
+// clears that reason otherwise. It returns true if a condition was changed.
+func SetQuotaExceededReason(conditions []*ackv1alpha1.Condition, err error) bool {
+	var recoverable *ackv1alpha1.Condition
+	for _, condition := range conditions {
+		if condition.Type == ackv1alpha1.ConditionTypeRecoverable {
+			recoverable = condition
+			break
+		}
+	}
+	if recoverable == nil {
+		return false
+	}
+
+	var apiErr smithy.APIError
+	quotaExceeded := recoverable.Status == corev1.ConditionTrue &&
+		errors.As(err, &apiErr) && isQuotaErrorCode(apiErr.ErrorCode())
+	switch {
+	case quotaExceeded:
+		if recoverable.Reason != nil && *recoverable.Reason == ConditionReasonQuotaExceeded {
+			return false
+		}
+		reason := ConditionReasonQuotaExceeded
+		recoverable.Reason = &reason
+		return true
+	case recoverable.Reason != nil && *recoverable.Reason == ConditionReasonQuotaExceeded:
+		recoverable.Reason = nil
+		return true
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithy "github.com/aws/smithy-go"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func apiError(code string) error {
+	return fmt.Errorf("operation error: %w", &smithy.GenericAPIError{Code: code, Message: "message"})
+}
+
+func TestRequeueOnAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		minAfter time.Duration
+		maxAfter time.Duration
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("failed"),
+		},
+		{
+			name: "validation error",
+			err:  apiError("InvalidParameterValue"),
+		},
+		{
+			name:     "throttling",
+			err:      apiError("Throttling"),
+			minAfter: ThrottlingRequeueAfter,
+			maxAfter: 2 * ThrottlingRequeueAfter,
+		},
+		{
+			name:     "quota",
+			err:      apiError("LimitExceeded"),
+			minAfter: QuotaRequeueAfter,
+			maxAfter: QuotaRequeueAfter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RequeueOnAPIError(tt.err)
+			var requeue *ackrequeue.RequeueNeededAfter
+			if !errors.As(got, &requeue) {
+				if tt.maxAfter != 0 {
+					t.Fatalf("RequeueOnAPIError() = %v, want a requeue", got)
+				}
+				if got != tt.err {
+					t.Errorf("RequeueOnAPIError() = %v, want %v", got, tt.err)
+				}
+				return
+			}
+			if after := requeue.Duration(); after < tt.minAfter || after > tt.maxAfter {
+				t.Errorf("requeue after %s, want between %s and %s", after, tt.minAfter, tt.maxAfter)
+			}
+			var apiErr smithy.APIError
+			if !errors.As(got, &apiErr) {
+				t.Errorf("RequeueOnAPIError() = %v, want it to unwrap to the API error", got)
+			}
+		})
+	}
+}
+
+func TestSetQuotaExceededReason(t *testing.T) {
+	recoverable := func(status corev1.ConditionStatus, reason *string) []*ackv1alpha1.Condition {
+		return []*ackv1alpha1.Condition{{
+			Type:   ackv1alpha1.ConditionTypeRecoverable,
+			Status: status,
+			Reason: reason,
+		}}
+	}
+
+	tests := []struct {
+		name        string
+		conditions  []*ackv1alpha1.Condition
+		err         error
+		wantUpdated bool
+		wantReason  *string
+	}{
+		{
+			name: "no recoverable condition",
+			err:  apiError("LimitExceeded"),
+		},
+		{
+			name:        "quota error",
+			conditions:  recoverable(corev1.ConditionTrue, nil),
+			err:         RequeueOnAPIError(apiError("LimitExceeded")),
+			wantUpdated: true,
+			wantReason:  aws.String(ConditionReasonQuotaExceeded),
+		},
+		{
+			name:       "quota error with reason already set",
+			conditions: recoverable(corev1.ConditionTrue, aws.String(ConditionReasonQuotaExceeded)),
+			err:        apiError("LimitExceededException"),
+			wantReason: aws.String(ConditionReasonQuotaExceeded),
+		},
+		{
+			name:        "other error after a quota error",
+			conditions:  recoverable(corev1.ConditionTrue, aws.String(ConditionReasonQuotaExceeded)),
+			err:         apiError("Throttling"),
+			wantUpdated: true,
+		},
+		{
+			name:        "recovered",
+			conditions:  recoverable(corev1.ConditionFalse, aws.String(ConditionReasonQuotaExceeded)),
+			wantUpdated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetQuotaExceededReason(tt.conditions, tt.err); got != tt.wantUpdated {
+				t.Errorf("SetQuotaExceededReason() = %v, want %v", got, tt.wantUpdated)
+			}
+			if len(tt.conditions) == 0 {
+				return
+			}
+			if got := tt.conditions[0].Reason; aws.ToString(got) != aws.ToString(tt.wantReason) {
+				t.Errorf("Reason = %v, want %v", aws.ToString(got), aws.ToString(tt.wantReason))
+			}
+		})
+	}
+}
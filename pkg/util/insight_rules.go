@@ -0,0 +1,57 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// InsightRuleARN returns the ARN of the Contributor Insights rule with the
+// supplied name. DescribeInsightRules and ListManagedInsightRules don't
+// return it, and it is needed to read and update the rule's tags.
+func InsightRuleARN(
+	partition ackv1alpha1.AWSPartition,
+	region ackv1alpha1.AWSRegion,
+	accountID ackv1alpha1.AWSAccountID,
+	name string,
+) string {
+	return fmt.Sprintf(
+		"arn:%s:cloudwatch:%s:%s:insight-rule/%s",
+		partition, region, accountID, name,
+	)
+}
+
+// InsightRuleFailure returns an error for the first failure reported by
+// PutManagedInsightRules, EnableInsightRules, DisableInsightRules or
+// DeleteInsightRules. These operations report failures for individual rules
+// in the response instead of returning an error. A rule that doesn't exist
+// any more isn't a failure.
+func InsightRuleFailure(failures []svcsdktypes.PartialFailure) error {
+	for _, failure := range failures {
+		if aws.ToString(failure.ExceptionType) == "ResourceNotFound" {
+			continue
+		}
+		return fmt.Errorf(
+			"insight rule %s: %s: %s",
+			aws.ToString(failure.FailureResource),
+			aws.ToString(failure.ExceptionType),
+			aws.ToString(failure.FailureDescription),
+		)
+	}
+	return nil
+}
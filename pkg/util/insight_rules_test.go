@@ -0,0 +1,44 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+func TestInsightRuleFailure(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures []svcsdktypes.PartialFailure
+		wantErr  bool
+	}{
+		{
+			name: "no failures",
+		},
+		{
+			name: "rule already deleted",
+			failures: []svcsdktypes.PartialFailure{{
+				ExceptionType:   aws.String("ResourceNotFound"),
+				FailureResource: aws.String("vpc-top-talkers"),
+			}},
+		},
+		{
+			name: "rule failure",
+			failures: []svcsdktypes.PartialFailure{{
+				ExceptionType:      aws.String("InvalidParameterValue"),
+				FailureDescription: aws.String("managed rules can't be deleted"),
+				FailureResource:    aws.String("vpc-top-talkers"),
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := InsightRuleFailure(tt.failures); (err != nil) != tt.wantErr {
+				t.Errorf("InsightRuleFailure() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
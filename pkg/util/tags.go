@@ -0,0 +1,233 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+// metricsRecorder records the outcome of the tagging API calls.
+type metricsRecorder interface {
+	RecordAPICall(opType string, opID string, apiErr error)
+}
+
+// tagsClient is the subset of the CloudWatch API used to manage the tags of
+// alarms, metric streams and dashboards.
+type tagsClient interface {
+	ListTagsForResource(context.Context, *svcsdk.ListTagsForResourceInput, ...func(*svcsdk.Options)) (*svcsdk.ListTagsForResourceOutput, error)
+	TagResource(context.Context, *svcsdk.TagResourceInput, ...func(*svcsdk.Options)) (*svcsdk.TagResourceOutput, error)
+	UntagResource(context.Context, *svcsdk.UntagResourceInput, ...func(*svcsdk.Options)) (*svcsdk.UntagResourceOutput, error)
+}
+
+// ToACKTags converts the tags of a resource spec into acktags.Tags and
+// returns the keys in the order they are listed in the spec. Each resource
+// package passes its generated convertToOrderedACKTags.
+type ToACKTags func(tags []*svcapitypes.Tag) (acktags.Tags, []string)
+
+// FromACKTags converts acktags.Tags back into the tags of a resource spec,
+// listing the keys of keyOrder first. Each resource package passes its
+// generated fromACKTags.
+type FromACKTags func(tags acktags.Tags, keyOrder []string) []*svcapitypes.Tag
+
+// SyncAWSTags copies the aws: tags of b, which can't be changed, into a.
+// Each resource package passes its generated syncAWSTags.
+type SyncAWSTags func(a acktags.Tags, b acktags.Tags)
+
+// IgnoreSystemTags removes the aws: tags and the supplied systemTags from
+// tags. Each resource package passes its generated ignoreSystemTags.
+type IgnoreSystemTags func(tags acktags.Tags, systemTags []string)
+
+// GetTags returns the tags attached to the CloudWatch resource with the
+// metadata, listed in the same order as specTags. The Describe, Get and List
+// operations of CloudWatch don't return tags, so they are read with
+// ListTagsForResource. No tags are returned while the ARN of the resource is
+// not known.
+func GetTags(
+	ctx context.Context,
+	client tagsClient,
+	mr metricsRecorder,
+	metadata *ackv1alpha1.ResourceMetadata,
+	specTags []*svcapitypes.Tag,
+	toACKTags ToACKTags,
+	fromACKTags FromACKTags,
+) ([]*svcapitypes.Tag, error) {
+	if metadata == nil || metadata.ARN == nil {
+		return nil, nil
+	}
+	tags, err := GetResourceTags(ctx, client, mr, string(*metadata.ARN))
+	if err != nil || tags == nil {
+		return nil, err
+	}
+	_, keyOrder := toACKTags(specTags)
+	latestTags, _ := toACKTags(tags)
+	return fromACKTags(latestTags, keyOrder), nil
+}
+
+// SyncTags calls TagResource and UntagResource so that the tags attached to
+// the CloudWatch resource with the latest metadata match desiredTags. The
+// Put operations of CloudWatch ignore Tags when they update an existing
+// resource, so tags can only be changed this way. The aws: tags and the
+// systemTags set with --resource-tags are never removed, even when they are
+// missing from desiredTags, see SyncAWSTags and IgnoreSystemTags.
+func SyncTags(
+	ctx context.Context,
+	client tagsClient,
+	mr metricsRecorder,
+	metadata *ackv1alpha1.ResourceMetadata,
+	desiredTags []*svcapitypes.Tag,
+	latestTags []*svcapitypes.Tag,
+	systemTags []string,
+	toACKTags ToACKTags,
+	syncAWSTags SyncAWSTags,
+	ignoreSystemTags IgnoreSystemTags,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("util.SyncTags")
+	defer func() { exit(err) }()
+
+	if metadata == nil || metadata.ARN == nil {
+		return ackrequeue.NeededAfter(
+			errors.New("resource ARN is not known yet, cannot update tags"),
+			ackrequeue.DefaultRequeueAfterDuration,
+		)
+	}
+
+	desired, _ := toACKTags(desiredTags)
+	latest, _ := toACKTags(latestTags)
+	syncAWSTags(desired, latest)
+	toAdd, toRemove := ComputeTagsDelta(desired, latest)
+	ignoreSystemTags(toRemove, systemTags)
+	return SyncResourceTags(ctx, client, mr, string(*metadata.ARN), toAdd, toRemove)
+}
+
+// GetResourceTags returns the tags attached to the CloudWatch resource with
+// the supplied ARN.
+func GetResourceTags(
+	ctx context.Context,
+	client tagsClient,
+	mr metricsRecorder,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	resp, err := client.ListTagsForResource(
+		ctx,
+		&svcsdk.ListTagsForResourceInput{ResourceARN: aws.String(resourceARN)},
+	)
+	mr.RecordAPICall("READ_MANY", "ListTagsForResource", err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Tags) == 0 {
+		return nil, nil
+	}
+	tags := make([]*svcapitypes.Tag, 0, len(resp.Tags))
+	for _, t := range resp.Tags {
+		tags = append(tags, &svcapitypes.Tag{Key: t.Key, Value: t.Value})
+	}
+	return tags, nil
+}
+
+// SyncResourceTags calls TagResource with the toAdd tags and UntagResource
+// with the keys of the toRemove tags for the CloudWatch resource with the
+// supplied ARN. Tags are removed before new ones are added so that the
+// resource never goes over the tag limit.
+func SyncResourceTags(
+	ctx context.Context,
+	client tagsClient,
+	mr metricsRecorder,
+	resourceARN string,
+	toAdd acktags.Tags,
+	toRemove acktags.Tags,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("util.SyncResourceTags")
+	defer func() { exit(err) }()
+
+	if len(toRemove) > 0 {
+		keys := sortedKeys(toRemove)
+		rlog.Debug("removing tags from resource", "keys", keys)
+		_, err = client.UntagResource(ctx, &svcsdk.UntagResourceInput{
+			ResourceARN: aws.String(resourceARN),
+			TagKeys:     keys,
+		})
+		mr.RecordAPICall("UPDATE", "UntagResource", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(toAdd) > 0 {
+		keys := sortedKeys(toAdd)
+		rlog.Debug("adding tags to resource", "keys", keys)
+		tags := make([]svcsdktypes.Tag, 0, len(keys))
+		for _, k := range keys {
+			tags = append(tags, svcsdktypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(toAdd[k]),
+			})
+		}
+		_, err = client.TagResource(ctx, &svcsdk.TagResourceInput{
+			ResourceARN: aws.String(resourceARN),
+			Tags:        tags,
+		})
+		mr.RecordAPICall("UPDATE", "TagResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ComputeTagsDelta compares the desired and latest tags and returns the tags
+// that need to be added or updated and the tags that need to be removed.
+func ComputeTagsDelta(
+	desired acktags.Tags,
+	latest acktags.Tags,
+) (toAdd acktags.Tags, toRemove acktags.Tags) {
+	toAdd = acktags.NewTags()
+	toRemove = acktags.NewTags()
+	for k, v := range desired {
+		if lv, ok := latest[k]; !ok || lv != v {
+			toAdd[k] = v
+		}
+	}
+	for k, v := range latest {
+		if _, ok := desired[k]; !ok {
+			toRemove[k] = v
+		}
+	}
+	return toAdd, toRemove
+}
+
+// sortedKeys returns the keys of the supplied tags in lexical order, so that
+// the API calls are deterministic.
+func sortedKeys(tags acktags.Tags) []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,198 @@
+package util
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/cloudwatch-controller/apis/v1alpha1"
+)
+
+func TestComputeTagsDelta(t *testing.T) {
+	tests := []struct {
+		name         string
+		desired      acktags.Tags
+		latest       acktags.Tags
+		wantToAdd    acktags.Tags
+		wantToRemove acktags.Tags
+	}{
+		{
+			name:         "equal",
+			desired:      acktags.Tags{"env": "prod"},
+			latest:       acktags.Tags{"env": "prod"},
+			wantToAdd:    acktags.Tags{},
+			wantToRemove: acktags.Tags{},
+		},
+		{
+			name:         "added, updated and removed",
+			desired:      acktags.Tags{"env": "prod", "team": "obs"},
+			latest:       acktags.Tags{"env": "dev", "owner": "alice"},
+			wantToAdd:    acktags.Tags{"env": "prod", "team": "obs"},
+			wantToRemove: acktags.Tags{"owner": "alice"},
+		},
+		{
+			name:         "no latest tags",
+			desired:      acktags.Tags{"env": "prod"},
+			wantToAdd:    acktags.Tags{"env": "prod"},
+			wantToRemove: acktags.Tags{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove := ComputeTagsDelta(tt.desired, tt.latest)
+			if !reflect.DeepEqual(toAdd, tt.wantToAdd) {
+				t.Errorf("ComputeTagsDelta() toAdd = %v, want %v", toAdd, tt.wantToAdd)
+			}
+			if !reflect.DeepEqual(toRemove, tt.wantToRemove) {
+				t.Errorf("ComputeTagsDelta() toRemove = %v, want %v", toRemove, tt.wantToRemove)
+			}
+		})
+	}
+}
+
+type fakeTagsClient struct {
+	tags      []svcsdktypes.Tag
+	added     []string
+	removed   []string
+	callCount int
+}
+
+func (c *fakeTagsClient) ListTagsForResource(context.Context, *svcsdk.ListTagsForResourceInput, ...func(*svcsdk.Options)) (*svcsdk.ListTagsForResourceOutput, error) {
+	c.callCount++
+	return &svcsdk.ListTagsForResourceOutput{Tags: c.tags}, nil
+}
+
+func (c *fakeTagsClient) TagResource(_ context.Context, in *svcsdk.TagResourceInput, _ ...func(*svcsdk.Options)) (*svcsdk.TagResourceOutput, error) {
+	c.callCount++
+	for _, t := range in.Tags {
+		c.added = append(c.added, *t.Key)
+	}
+	return &svcsdk.TagResourceOutput{}, nil
+}
+
+func (c *fakeTagsClient) UntagResource(_ context.Context, in *svcsdk.UntagResourceInput, _ ...func(*svcsdk.Options)) (*svcsdk.UntagResourceOutput, error) {
+	c.callCount++
+	c.removed = append(c.removed, in.TagKeys...)
+	return &svcsdk.UntagResourceOutput{}, nil
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) RecordAPICall(string, string, error) {}
+
+func testToACKTags(tags []*svcapitypes.Tag) (acktags.Tags, []string) {
+	result := acktags.NewTags()
+	keyOrder := []string{}
+	for _, t := range tags {
+		keyOrder = append(keyOrder, *t.Key)
+		result[*t.Key] = aws.ToString(t.Value)
+	}
+	return result, keyOrder
+}
+
+// testSyncAWSTags and testIgnoreSystemTags are copies of the syncAWSTags and
+// ignoreSystemTags generated in each resource package.
+func testSyncAWSTags(a acktags.Tags, b acktags.Tags) {
+	for k := range b {
+		if strings.HasPrefix(k, "aws:") {
+			a[k] = b[k]
+		}
+	}
+}
+
+func testIgnoreSystemTags(tags acktags.Tags, systemTags []string) {
+	for k := range tags {
+		if strings.HasPrefix(k, "aws:") || slices.Contains(systemTags, k) {
+			delete(tags, k)
+		}
+	}
+}
+
+func testFromACKTags(tags acktags.Tags, keyOrder []string) []*svcapitypes.Tag {
+	result := []*svcapitypes.Tag{}
+	for _, k := range keyOrder {
+		if v, ok := tags[k]; ok {
+			result = append(result, &svcapitypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+			delete(tags, k)
+		}
+	}
+	for _, k := range sortedKeys(tags) {
+		result = append(result, &svcapitypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return result
+}
+
+func testTags(kv ...string) []*svcapitypes.Tag {
+	tags := []*svcapitypes.Tag{}
+	for i := 0; i < len(kv); i += 2 {
+		tags = append(tags, &svcapitypes.Tag{Key: aws.String(kv[i]), Value: aws.String(kv[i+1])})
+	}
+	return tags
+}
+
+func TestGetTags(t *testing.T) {
+	resourceARN := ackv1alpha1.AWSResourceName("arn:aws:cloudwatch:us-west-2:123456789012:alarm:cpu-high")
+	client := &fakeTagsClient{tags: []svcsdktypes.Tag{
+		{Key: aws.String("env"), Value: aws.String("prod")},
+		{Key: aws.String("team"), Value: aws.String("obs")},
+	}}
+
+	tags, err := GetTags(
+		context.TODO(), client, noopMetrics{}, nil,
+		nil, testToACKTags, testFromACKTags,
+	)
+	if err != nil || tags != nil || client.callCount != 0 {
+		t.Fatalf("GetTags() without ARN = %v, %v after %d calls, want no tags and no calls", tags, err, client.callCount)
+	}
+
+	tags, err = GetTags(
+		context.TODO(), client, noopMetrics{}, &ackv1alpha1.ResourceMetadata{ARN: &resourceARN},
+		testTags("team", "", "env", ""), testToACKTags, testFromACKTags,
+	)
+	if err != nil {
+		t.Fatalf("GetTags() error = %v", err)
+	}
+	if want := testTags("team", "obs", "env", "prod"); !reflect.DeepEqual(tags, want) {
+		t.Errorf("GetTags() = %v, want the tags in spec order %v", tags, want)
+	}
+}
+
+func TestSyncTags(t *testing.T) {
+	resourceARN := ackv1alpha1.AWSResourceName("arn:aws:cloudwatch:us-west-2:123456789012:alarm:cpu-high")
+	client := &fakeTagsClient{}
+
+	err := SyncTags(
+		context.TODO(), client, noopMetrics{}, nil,
+		testTags("env", "prod"), nil, nil,
+		testToACKTags, testSyncAWSTags, testIgnoreSystemTags,
+	)
+	if err == nil || client.callCount != 0 {
+		t.Fatalf("SyncTags() without ARN = %v after %d calls, want a requeue and no calls", err, client.callCount)
+	}
+
+	err = SyncTags(
+		context.TODO(), client, noopMetrics{}, &ackv1alpha1.ResourceMetadata{ARN: &resourceARN},
+		testTags("env", "prod", "team", "obs"),
+		testTags("env", "dev", "owner", "alice", "aws:cloudformation:stack-name", "stack", "services.k8s.aws/namespace", "default"),
+		[]string{"services.k8s.aws/namespace"},
+		testToACKTags, testSyncAWSTags, testIgnoreSystemTags,
+	)
+	if err != nil {
+		t.Fatalf("SyncTags() error = %v", err)
+	}
+	if want := []string{"env", "team"}; !reflect.DeepEqual(client.added, want) {
+		t.Errorf("SyncTags() added %v, want %v", client.added, want)
+	}
+	if want := []string{"owner"}; !reflect.DeepEqual(client.removed, want) {
+		t.Errorf("SyncTags() removed %v, want %v", client.removed, want)
+	}
+}
@@ -0,0 +1,11 @@
+	// GetAlarmMuteRule only returns the alarm names, keep the MetricAlarm
+	// references they were resolved from.
+	if ko.Spec.MuteTargets != nil && r.ko.Spec.MuteTargets != nil {
+		ko.Spec.MuteTargets.AlarmRefs = r.ko.Spec.MuteTargets.AlarmRefs
+	}
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
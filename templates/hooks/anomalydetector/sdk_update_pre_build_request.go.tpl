@@ -0,0 +1,3 @@
+	if err := validateAnomalyDetector(desired); err != nil {
+		return nil, err
+	}
@@ -0,0 +1 @@
+	err = commonutil.RequeueOnAPIError(err)
@@ -0,0 +1 @@
+	input.AlarmNames = []string{*r.ko.Spec.Name}
@@ -0,0 +1,3 @@
+	if err := rm.setAlarmRule(input, desired); err != nil {
+		return nil, err
+	}
@@ -1 +1,4 @@
-	input.DashboardNames = []string{*r.ko.Spec.DashboardName}
+	drift.Forget(&resourceDescriptor{}, r)
+	if input.DashboardNames, err = rm.deletions().Next(*r.ko.Spec.DashboardName); err != nil {
+		return nil, err
+	}
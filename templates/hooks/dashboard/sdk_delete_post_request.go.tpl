@@ -0,0 +1 @@
+	err = rm.deletions().Done(input.DashboardNames, err)
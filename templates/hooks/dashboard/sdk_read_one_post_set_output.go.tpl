@@ -0,0 +1,8 @@
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+	rm.omitDashboardBodyFrom(ctx, ko)
+	drift.Observe(&resourceDescriptor{}, &resource{ko})
@@ -0,0 +1,6 @@
+	if err := rm.setDashboardBody(ctx, input, desired); err != nil {
+		return nil, err
+	}
+	if invalid, err := validateRenderedBody(input, desired); err != nil {
+		return invalid, err
+	}
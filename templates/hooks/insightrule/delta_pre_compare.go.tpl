@@ -0,0 +1 @@
+	compareRuleState(delta, a, b)
@@ -0,0 +1 @@
+	input.RuleNames = []string{*r.ko.Status.RuleName}
@@ -0,0 +1,3 @@
+	if err == nil {
+		err = commonutil.InsightRuleFailure(resp.Failures)
+	}
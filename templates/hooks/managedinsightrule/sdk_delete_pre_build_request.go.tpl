@@ -0,0 +1,4 @@
+	// The rule name is only known once the managed rule has been enabled.
+	if r.ko.Status.RuleName == nil {
+		return nil, nil
+	}
@@ -0,0 +1 @@
+	compareUnorderedFields(delta, a, b)
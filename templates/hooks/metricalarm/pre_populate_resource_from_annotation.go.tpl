@@ -0,0 +1,3 @@
+	if arn, ok := fields["arn"]; ok && fields["name"] == "" {
+		return r.setIdentifiersFromARN(arn)
+	}
@@ -0,0 +1,3 @@
+	if identifier.NameOrID == "" && identifier.ARN != nil {
+		return r.setIdentifiersFromARN(string(*identifier.ARN))
+	}
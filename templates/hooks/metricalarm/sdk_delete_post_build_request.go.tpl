@@ -1 +1,4 @@
-	input.AlarmNames = []string{*r.ko.Spec.Name}
+	drift.Forget(&resourceDescriptor{}, r)
+	if input.AlarmNames, err = rm.deletions().Next(*r.ko.Spec.Name); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,3 @@
+	rm.alarmSnapshot().invalidate(input.AlarmNames...)
+	err = rm.deletions().Done(input.AlarmNames, err)
+	err = commonutil.RequeueOnAPIError(err)
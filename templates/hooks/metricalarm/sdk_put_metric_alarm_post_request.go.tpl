@@ -0,0 +1,2 @@
+	rm.alarmSnapshot().invalidate(*input.AlarmName)
+	err = commonutil.RequeueOnAPIError(err)
@@ -1 +1,4 @@
+	if err := rm.checkAlarmARNScope(r); err != nil {
+		return nil, err
+	}
 	input.AlarmNames = []string{*r.ko.Spec.Name}
@@ -0,0 +1,22 @@
+	for _, elem := range resp.MetricAlarms {
+		if elem.AlarmArn == nil || aws.ToString(elem.AlarmName) != *ko.Spec.Name {
+			continue
+		}
+		if ko.Status.ACKResourceMetadata == nil {
+			ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+		}
+		arn := ackv1alpha1.AWSResourceName(*elem.AlarmArn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+		break
+	}
+	sortAlarmLists(ko)
+	rm.restoreTypedActions(ko, r.ko)
+	if ko.Spec.Tags, err = rm.alarmSnapshot().tags(ctx, *ko.Spec.Name, func(ctx context.Context) ([]*svcapitypes.Tag, error) {
+		return commonutil.GetTags(
+			ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+			ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+		)
+	}); err != nil {
+		return nil, err
+	}
+	drift.Observe(&resourceDescriptor{}, &resource{ko})
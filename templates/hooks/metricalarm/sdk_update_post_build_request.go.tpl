@@ -0,0 +1,3 @@
+	if err := rm.setAlarmActions(input, desired); err != nil {
+		return nil, err
+	}
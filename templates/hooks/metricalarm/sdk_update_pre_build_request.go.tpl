@@ -0,0 +1,15 @@
+	drift.Forget(&resourceDescriptor{}, desired)
+	if delta.DifferentAt("Spec.Tags") {
+		if err = commonutil.SyncTags(
+			ctx, rm.sdkapi, rm.metrics, latest.ko.Status.ACKResourceMetadata,
+			desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+			rm.cfg.ResourceTagKeys, convertToOrderedACKTags,
+			syncAWSTags, ignoreSystemTags,
+		); err != nil {
+			return nil, err
+		}
+		rm.alarmSnapshot().invalidate(*desired.ko.Spec.Name)
+	}
+	if !delta.DifferentExcept("Spec.Tags") {
+		return desired, nil
+	}
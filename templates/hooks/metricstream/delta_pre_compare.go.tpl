@@ -0,0 +1 @@
+	compareStreamState(delta, a, b)
@@ -0,0 +1,6 @@
+	// PutMetricStream always creates the stream in the running state.
+	if ko.Spec.State != nil && *ko.Spec.State != metricStreamStateRunning {
+		if err = rm.updateStreamState(ctx, &resource{ko}); err != nil {
+			return nil, err
+		}
+	}
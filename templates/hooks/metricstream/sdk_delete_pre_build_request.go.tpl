@@ -0,0 +1 @@
+	drift.Forget(&resourceDescriptor{}, r)
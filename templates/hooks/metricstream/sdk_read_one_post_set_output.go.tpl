@@ -0,0 +1,8 @@
+	ko.Status.StreamState = resp.State
+	if ko.Spec.Tags, err = commonutil.GetTags(
+		ctx, rm.sdkapi, rm.metrics, ko.Status.ACKResourceMetadata,
+		ko.Spec.Tags, convertToOrderedACKTags, fromACKTags,
+	); err != nil {
+		return nil, err
+	}
+	drift.Observe(&resourceDescriptor{}, &resource{ko})
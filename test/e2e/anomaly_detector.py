@@ -0,0 +1,80 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Utilities for working with Anomaly Detector resources"""
+
+import datetime
+import time
+
+import boto3
+import pytest
+
+DEFAULT_WAIT_UNTIL_DELETED_TIMEOUT_SECONDS = 60*20
+DEFAULT_WAIT_UNTIL_DELETED_INTERVAL_SECONDS = 15
+
+
+def wait_until_deleted(
+        namespace: str,
+        metric_name: str,
+        timeout_seconds: int = DEFAULT_WAIT_UNTIL_DELETED_TIMEOUT_SECONDS,
+        interval_seconds: int = DEFAULT_WAIT_UNTIL_DELETED_INTERVAL_SECONDS,
+    ) -> None:
+    """Waits until the single metric Anomaly Detector for the supplied metric is
+    no longer returned from the CloudWatch API.
+
+    Usage:
+        from e2e.anomaly_detector import wait_until_deleted
+
+        wait_until_deleted(namespace, metric_name)
+
+    Raises:
+        pytest.fail upon timeout
+    """
+    now = datetime.datetime.now()
+    timeout = now + datetime.timedelta(seconds=timeout_seconds)
+
+    while True:
+        if datetime.datetime.now() >= timeout:
+            pytest.fail(
+                "Timed out waiting for Anomaly Detector to be "
+                "deleted in CloudWatch API"
+            )
+        time.sleep(interval_seconds)
+
+        latest = get(namespace, metric_name)
+        if latest is None:
+            break
+
+
+def exists(namespace, metric_name):
+    """Returns True if a single metric Anomaly Detector exists for the supplied
+    metric, False otherwise.
+    """
+    return get(namespace, metric_name) is not None
+
+
+def get(namespace, metric_name):
+    """Returns a dict containing the single metric Anomaly Detector record for
+    the supplied metric from the CloudWatch API.
+
+    If no such Anomaly Detector exists, returns None.
+    """
+    c = boto3.client('cloudwatch')
+    resp = c.describe_anomaly_detectors(
+        Namespace=namespace,
+        MetricName=metric_name,
+        AnomalyDetectorTypes=['SINGLE_METRIC'],
+    )
+    if len(resp['AnomalyDetectors']) == 1:
+        return resp['AnomalyDetectors'][0]
+    return None
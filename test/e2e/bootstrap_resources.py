@@ -24,6 +24,7 @@ from e2e import bootstrap_directory
 @dataclass
 class BootstrapResources(Resources):
     MetricStreamRole: Role
+    ScheduledQueryRole: Role
     DeliveryStream: DeliveryStream
 
 _bootstrap_resources = None
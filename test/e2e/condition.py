@@ -27,6 +27,7 @@ CONDITION_TYPE_TERMINAL = "ACK.Terminal"
 CONDITION_TYPE_RECOVERABLE = "ACK.Recoverable"
 CONDITION_TYPE_ADVISORY = "ACK.Advisory"
 CONDITION_TYPE_LATE_INITIALIZED = "ACK.LateInitialized"
+CONDITION_TYPE_ALARM_FIRING = "CloudWatch.AlarmFiring"
 
 
 def assert_type_status(
@@ -0,0 +1,103 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Utilities for working with Managed Insight Rule resources"""
+
+import datetime
+import time
+
+import boto3
+import pytest
+
+DEFAULT_WAIT_UNTIL_DELETED_TIMEOUT_SECONDS = 60*20
+DEFAULT_WAIT_UNTIL_DELETED_INTERVAL_SECONDS = 15
+
+
+def wait_until_deleted(
+        resource_arn: str,
+        template_name: str,
+        timeout_seconds: int = DEFAULT_WAIT_UNTIL_DELETED_TIMEOUT_SECONDS,
+        interval_seconds: int = DEFAULT_WAIT_UNTIL_DELETED_INTERVAL_SECONDS,
+    ) -> None:
+    """Waits until the managed rule created from a template is no longer
+    enabled for a resource ARN in the CloudWatch API.
+
+    Usage:
+        from e2e.managed_insight_rule import wait_until_deleted
+
+        wait_until_deleted(resource_arn, template_name)
+
+    Raises:
+        pytest.fail upon timeout
+    """
+    now = datetime.datetime.now()
+    timeout = now + datetime.timedelta(seconds=timeout_seconds)
+
+    while True:
+        if datetime.datetime.now() >= timeout:
+            pytest.fail(
+                "Timed out waiting for Managed Insight Rule to be "
+                "deleted in CloudWatch API"
+            )
+        time.sleep(interval_seconds)
+
+        latest = get(resource_arn, template_name)
+        if latest is None:
+            break
+
+
+def exists(resource_arn, template_name):
+    """Returns True if the managed rule created from the supplied template is
+    enabled for the resource ARN, False otherwise.
+    """
+    return get(resource_arn, template_name) is not None
+
+
+def get(resource_arn, template_name):
+    """Returns a dict containing the managed rule record from the CloudWatch
+    API.
+
+    If the template isn't enabled for the resource ARN, returns None.
+    """
+    for rule in list_templates(resource_arn):
+        if rule['TemplateName'] == template_name and 'RuleState' in rule:
+            return rule
+    return None
+
+
+def list_templates(resource_arn):
+    """Returns the managed rule records of every template available for the
+    resource ARN, enabled or not.
+    """
+    c = boto3.client('cloudwatch')
+    paginator = c.get_paginator('list_managed_insight_rules')
+    rules = []
+    for page in paginator.paginate(ResourceARN=resource_arn):
+        rules.extend(page['ManagedRules'])
+    return rules
+
+
+def get_tags(rule_arn):
+    """Returns a dict containing the managed rule's tag records from the
+    CloudWatch API.
+
+    If no such rule exists, returns None.
+    """
+    c = boto3.client('cloudwatch')
+    try:
+        resp = c.list_tags_for_resource(
+            ResourceARN=rule_arn,
+        )
+        return resp['Tags']
+    except c.exceptions.ResourceNotFoundException:
+        return None
@@ -72,4 +72,19 @@ def get(metric_stream_name):
         resp = c.get_metric_stream(Name=metric_stream_name)
         return resp
     except c.exceptions.ResourceNotFoundException:
-        return None
\ No newline at end of file
+        return None
+
+def get_tags(metric_stream_arn):
+    """Returns a list containing the Metric Stream's tag records from the
+    CloudWatch API.
+
+    If no such Metric Stream exists, returns None.
+    """
+    c = boto3.client('cloudwatch')
+    try:
+        resp = c.list_tags_for_resource(
+            ResourceARN=metric_stream_arn,
+        )
+        return resp['Tags']
+    except c.exceptions.ResourceNotFoundException:
+        return None
@@ -32,6 +32,20 @@ def service_bootstrap() -> Resources:
         }]
     }
 
+    scheduled_query_policy_doc = {
+        "Version": "2012-10-17",
+        "Statement": [{
+            "Effect": "Allow",
+            "Action": [
+                "logs:StartQuery",
+                "logs:StopQuery",
+                "logs:GetQueryResults",
+                "logs:DescribeLogGroups"
+            ],
+            "Resource": "*"
+        }]
+    }
+
     resources = BootstrapResources(
         MetricStreamRole=Role(
             name_prefix="cloudwatch-metric-stream-role",
@@ -42,6 +56,15 @@ def service_bootstrap() -> Resources:
                 policy_documents=[json.dumps(metric_stream_policy_doc)]
             )
         ),
+        ScheduledQueryRole=Role(
+            name_prefix="cloudwatch-scheduled-query-role",
+            principal_service="logs.amazonaws.com",
+            description="Role for CloudWatch Log Alarm scheduled queries",
+            user_policies=UserPolicies(
+                name_prefix="scheduled-query-logs-policy",
+                policy_documents=[json.dumps(scheduled_query_policy_doc)]
+            )
+        ),
         DeliveryStream=DeliveryStream(
             name_prefix="cloudwatch-metric-stream",
             s3_bucket_prefix="ack-test-cw-metrics"
@@ -0,0 +1,152 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the CloudWatch API AlarmMuteRule resource
+"""
+
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_cloudwatch_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e import condition
+from e2e import alarm_mute_rule
+from e2e import metric_alarm
+
+RESOURCE_PLURAL = 'alarmmuterules'
+METRIC_ALARM_RESOURCE_PLURAL = 'metricalarms'
+
+CHECK_STATUS_WAIT_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 5
+
+
+@pytest.fixture
+def _metric_alarm():
+    metric_alarm_name = random_suffix_name("ack-test-muted-alarm", 24)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["METRIC_ALARM_NAME"] = metric_alarm_name
+    resource_data = load_cloudwatch_resource(
+        "metric_alarm",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, METRIC_ALARM_RESOURCE_PLURAL,
+        metric_alarm_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    metric_alarm.wait_until_deleted(metric_alarm_name)
+
+
+@pytest.fixture
+def _alarm_mute_rule(_metric_alarm):
+    (metric_alarm_ref, _) = _metric_alarm
+    rule_name = random_suffix_name("ack-test-mute-rule", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["ALARM_MUTE_RULE_NAME"] = rule_name
+    replacements["METRIC_ALARM_NAME"] = metric_alarm_ref.name
+    resource_data = load_cloudwatch_resource(
+        "alarm_mute_rule",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        rule_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    alarm_mute_rule.wait_until_deleted(rule_name)
+
+
+@service_marker
+@pytest.mark.canary
+class TestAlarmMuteRule:
+    def test_crud(self, _alarm_mute_rule, _metric_alarm):
+        (ref, cr) = _alarm_mute_rule
+        (metric_alarm_ref, _) = _metric_alarm
+        rule_name = ref.name
+
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        condition.assert_synced(ref)
+
+        rule = alarm_mute_rule.get(rule_name)
+        assert rule is not None
+        assert rule['MuteTargets']['AlarmNames'] == [metric_alarm_ref.name]
+        assert rule['Rule']['Schedule']['Duration'] == "PT4H"
+
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["state"] in ("SCHEDULED", "ACTIVE")
+        assert cr["status"]["muteType"] == "RECURRING"
+        arn = cr["status"]["ackResourceMetadata"]["arn"]
+
+        tags = alarm_mute_rule.get_tags(arn)
+        assert {"Key": "environment", "Value": "dev"} in tags
+
+        # Change the schedule, set an expiry date and change the tags
+        updates = {
+            "spec": {
+                "rule": {
+                    "schedule": {
+                        "expression": "cron(0 20 * * FRI)",
+                        "duration": "PT2H",
+                    },
+                },
+                "expireDate": "2099-12-31T23:59:59Z",
+                "tags": [{"key": "environment", "value": "prod"}],
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        rule = alarm_mute_rule.get(rule_name)
+        assert rule['Rule']['Schedule']['Expression'] == "cron(0 20 * * FRI)"
+        assert rule['Rule']['Schedule']['Duration'] == "PT2H"
+        assert rule['ExpireDate'].year == 2099
+
+        tags = alarm_mute_rule.get_tags(arn)
+        assert {"Key": "environment", "Value": "prod"} in tags
+        assert {"Key": "environment", "Value": "dev"} not in tags
@@ -0,0 +1,117 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the CloudWatch API AnomalyDetector resource
+"""
+
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_cloudwatch_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e import condition
+from e2e import anomaly_detector
+
+RESOURCE_PLURAL = 'anomalydetectors'
+METRIC_NAMESPACE = 'ACK/E2E'
+
+CHECK_STATUS_WAIT_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 5
+
+
+@pytest.fixture
+def _anomaly_detector():
+    name = random_suffix_name("ack-test-anomaly-detector", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["ANOMALY_DETECTOR_NAME"] = name
+    replacements["METRIC_NAMESPACE"] = METRIC_NAMESPACE
+    replacements["METRIC_NAME"] = name
+    resource_data = load_cloudwatch_resource(
+        "anomaly_detector",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    anomaly_detector.wait_until_deleted(METRIC_NAMESPACE, name)
+
+
+@service_marker
+@pytest.mark.canary
+class TestAnomalyDetector:
+    def test_crud(self, _anomaly_detector):
+        (ref, cr) = _anomaly_detector
+        metric_name = ref.name
+
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        condition.assert_synced(ref)
+
+        detector = anomaly_detector.get(METRIC_NAMESPACE, metric_name)
+        assert detector is not None
+        assert detector['Configuration']['MetricTimezone'] == "Europe/Paris"
+        assert len(detector['Configuration']['ExcludedTimeRanges']) == 1
+
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["anomalyDetectorID"] == detector['AnomalyDetectorId']
+        assert cr["status"]["stateValue"] in (
+            "PENDING_TRAINING",
+            "TRAINED_INSUFFICIENT_DATA",
+            "TRAINED",
+        )
+
+        updates = {
+            "spec": {
+                "configuration": {
+                    "excludedTimeRanges": [
+                        {
+                            "startTime": "2026-12-24T00:00:00Z",
+                            "endTime": "2026-12-26T00:00:00Z",
+                        },
+                        {
+                            "startTime": "2026-12-31T00:00:00Z",
+                            "endTime": "2027-01-02T00:00:00Z",
+                        },
+                    ],
+                    "metricTimezone": "UTC",
+                },
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        detector = anomaly_detector.get(METRIC_NAMESPACE, metric_name)
+        assert detector['Configuration']['MetricTimezone'] == "UTC"
+        assert len(detector['Configuration']['ExcludedTimeRanges']) == 2
@@ -0,0 +1,140 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the CloudWatch API CompositeAlarm resource
+"""
+
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_cloudwatch_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e import condition
+from e2e import composite_alarm
+from e2e import metric_alarm
+
+RESOURCE_PLURAL = 'compositealarms'
+METRIC_ALARM_RESOURCE_PLURAL = 'metricalarms'
+
+CHECK_STATUS_WAIT_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 5
+
+
+@pytest.fixture
+def _metric_alarm():
+    metric_alarm_name = random_suffix_name("ack-test-child-alarm", 24)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["METRIC_ALARM_NAME"] = metric_alarm_name
+    resource_data = load_cloudwatch_resource(
+        "metric_alarm",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, METRIC_ALARM_RESOURCE_PLURAL,
+        metric_alarm_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    metric_alarm.wait_until_deleted(metric_alarm_name)
+
+
+@pytest.fixture
+def _composite_alarm(_metric_alarm):
+    (metric_alarm_ref, _) = _metric_alarm
+    composite_alarm_name = random_suffix_name("ack-test-composite-alarm", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["COMPOSITE_ALARM_NAME"] = composite_alarm_name
+    replacements["METRIC_ALARM_NAME"] = metric_alarm_ref.name
+    resource_data = load_cloudwatch_resource(
+        "composite_alarm",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        composite_alarm_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, metric_alarm_ref)
+
+    # The composite alarm has to go before the alarms its rule refers to
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    composite_alarm.wait_until_deleted(composite_alarm_name)
+
+
+@service_marker
+@pytest.mark.canary
+class TestCompositeAlarm:
+    def test_crud(self, _composite_alarm):
+        (ref, cr, metric_alarm_ref) = _composite_alarm
+        composite_alarm_name = ref.name
+        metric_alarm_name = metric_alarm_ref.name
+
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        condition.assert_synced(metric_alarm_ref)
+        condition.assert_synced(ref)
+
+        assert composite_alarm.exists(composite_alarm_name)
+
+        # The placeholder is resolved to the MetricAlarm's name in CloudWatch
+        # while the CR keeps the templated rule
+        alarm = composite_alarm.get(composite_alarm_name)
+        assert alarm['AlarmRule'] == f'ALARM("{metric_alarm_name}")'
+
+        cr = k8s.get_resource(ref)
+        assert cr["spec"]["alarmRule"] == 'ALARM("${%s}")' % metric_alarm_name
+        assert cr["status"]["ackResourceMetadata"]["arn"] == alarm['AlarmArn']
+
+        updates = {
+            "spec": {
+                "alarmDescription": "updated description",
+                "alarmRule": 'NOT ALARM("${%s}")' % metric_alarm_name,
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        alarm = composite_alarm.get(composite_alarm_name)
+        assert alarm['AlarmDescription'] == "updated description"
+        assert alarm['AlarmRule'] == f'NOT ALARM("{metric_alarm_name}")'
@@ -16,13 +16,16 @@
 import time
 import json
 import pytest
+from kubernetes import client as k8s_client
 
 from acktest.k8s import resource as k8s, condition
 from acktest.resources import random_suffix_name
 from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_cloudwatch_resource
 from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e import metric_alarm
 
 RESOURCE_PLURAL = 'dashboards'
+METRIC_ALARM_RESOURCE_PLURAL = 'metricalarms'
 CHECK_WAIT_SECONDS = 5
 
 DASHBOARD_BODY_1 = r"""{
@@ -109,6 +112,149 @@ def simple_dashboard():
     )
     assert deleted
 
+@pytest.fixture
+def widgets_dashboard():
+    resource_name = random_suffix_name("ack-test-dashboard", 24)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["DASHBOARD_NAME"] = resource_name
+
+    resource_data = load_cloudwatch_resource(
+        "dashboard_widgets",
+        additional_replacements=replacements
+    )
+
+    # Create the k8s resource
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    # Try to delete, if doesn't already exist
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        wait_periods=5,
+        period_length=5,
+    )
+    assert deleted
+
+@pytest.fixture
+def alarm_refs_dashboard():
+    metric_alarm_name = random_suffix_name("ack-test-dashboard-alarm", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["METRIC_ALARM_NAME"] = metric_alarm_name
+    metric_alarm_data = load_cloudwatch_resource(
+        "metric_alarm",
+        additional_replacements=replacements,
+    )
+
+    metric_alarm_ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, METRIC_ALARM_RESOURCE_PLURAL,
+        metric_alarm_name, namespace="default",
+    )
+    k8s.create_custom_resource(metric_alarm_ref, metric_alarm_data)
+    assert k8s.wait_resource_consumed_by_controller(metric_alarm_ref) is not None
+
+    resource_name = random_suffix_name("ack-test-dashboard", 24)
+    replacements["DASHBOARD_NAME"] = resource_name
+    resource_data = load_cloudwatch_resource(
+        "dashboard_alarm_refs",
+        additional_replacements=replacements
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, metric_alarm_ref)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        wait_periods=5,
+        period_length=5,
+    )
+    assert deleted
+
+    _, deleted = k8s.delete_custom_resource(
+        metric_alarm_ref,
+        wait_periods=5,
+        period_length=5,
+    )
+    assert deleted
+
+    metric_alarm.wait_until_deleted(metric_alarm_name)
+
+DASHBOARD_TEMPLATE = r"""{
+    "widgets": [
+        {
+            "type": "metric",
+            "properties": {
+                "metrics": [
+                    [ "ContainerInsights", "node_cpu_utilization", "ClusterName", "${cluster}" ]
+                ],
+                "region": "${region}",
+                "title": "%s"
+            }
+        }
+    ]
+}
+"""
+
+@pytest.fixture
+def body_from_dashboard():
+    resource_name = random_suffix_name("ack-test-dashboard", 24)
+    config_map_name = random_suffix_name("ack-test-dashboard-body", 32)
+
+    core_v1 = k8s_client.CoreV1Api(k8s._get_k8s_api_client())
+    core_v1.create_namespaced_config_map("default", k8s_client.V1ConfigMap(
+        metadata=k8s_client.V1ObjectMeta(name=config_map_name),
+        data={"dashboard.json": DASHBOARD_TEMPLATE % "CPU"},
+    ))
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["DASHBOARD_NAME"] = resource_name
+    replacements["CONFIG_MAP_NAME"] = config_map_name
+
+    resource_data = load_cloudwatch_resource(
+        "dashboard_body_from",
+        additional_replacements=replacements
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        resource_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, core_v1, config_map_name)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        wait_periods=5,
+        period_length=5,
+    )
+    assert deleted
+
+    core_v1.delete_namespaced_config_map(config_map_name, "default")
+
 @service_marker
 @pytest.mark.canary
 class TestDashboard:
@@ -173,3 +319,114 @@ class TestDashboard:
         assert dashboard is not None
         assert dashboard['DashboardName'] == dashboard_name
         assert_json_equal(dashboard['DashboardBody'], json.dumps(new_dashboard))
+
+        # Tags are set when the dashboard is created and kept in sync with
+        # TagResource/UntagResource afterwards
+        dashboard_arn = dashboard['DashboardArn']
+        tags = cloudwatch_client.list_tags_for_resource(ResourceARN=dashboard_arn)['Tags']
+        assert {"Key": "environment", "Value": "test"} in tags
+
+        updates = {
+            "spec": {
+                "tags": [
+                    {"key": "environment", "value": "prod"},
+                    {"key": "team", "value": "observability"},
+                ],
+            },
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(CHECK_WAIT_SECONDS)
+        k8s.wait_on_condition(ref, condition.CONDITION_TYPE_RESOURCE_SYNCED, "True")
+
+        tags = cloudwatch_client.list_tags_for_resource(ResourceARN=dashboard_arn)['Tags']
+        assert {"Key": "environment", "Value": "prod"} in tags
+        assert {"Key": "team", "Value": "observability"} in tags
+
+    def test_crud_dashboard_widgets(self, widgets_dashboard, cloudwatch_client):
+        (ref, cr) = widgets_dashboard
+        k8s.wait_on_condition(ref, condition.CONDITION_TYPE_RESOURCE_SYNCED, "True")
+        condition.assert_synced(ref)
+
+        dashboard_name = cr['spec']['dashboardName']
+        dashboard = cloudwatch_client.get_dashboard(DashboardName=dashboard_name)
+        body = json.loads(dashboard['DashboardBody'])
+        assert len(body['widgets']) == 2
+        assert body['widgets'][0]['type'] == "metric"
+        assert body['widgets'][0]['properties']['metrics'] == [
+            ["AWS/EC2", "CPUUtilization", "InstanceId", "i-1234567890abcdef0"],
+        ]
+        assert body['widgets'][1]['type'] == "text"
+        assert body['widgets'][1]['properties']['markdown'] == f"# {dashboard_name}"
+
+        updates = {
+            "spec": {
+                "widgets": [
+                    {
+                        "x": 0,
+                        "y": 0,
+                        "width": 24,
+                        "height": 6,
+                        "text": {"markdown": "Updated"},
+                    },
+                ],
+            },
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(CHECK_WAIT_SECONDS)
+        k8s.wait_on_condition(ref, condition.CONDITION_TYPE_RESOURCE_SYNCED, "True")
+        condition.assert_synced(ref)
+
+        dashboard = cloudwatch_client.get_dashboard(DashboardName=dashboard_name)
+        body = json.loads(dashboard['DashboardBody'])
+        assert len(body['widgets']) == 1
+        assert body['widgets'][0]['type'] == "text"
+        assert body['widgets'][0]['width'] == 24
+        assert body['widgets'][0]['properties']['markdown'] == "Updated"
+
+    def test_dashboard_alarm_refs(self, alarm_refs_dashboard, cloudwatch_client):
+        (ref, cr, metric_alarm_ref) = alarm_refs_dashboard
+        k8s.wait_on_condition(metric_alarm_ref, condition.CONDITION_TYPE_RESOURCE_SYNCED, "True")
+        k8s.wait_on_condition(ref, condition.CONDITION_TYPE_RESOURCE_SYNCED, "True")
+        condition.assert_synced(ref)
+
+        # The placeholder is resolved to the MetricAlarm's ARN in CloudWatch
+        alarm_arn = metric_alarm.get(metric_alarm_ref.name)['AlarmArn']
+        dashboard_name = cr['spec']['dashboardName']
+        dashboard = cloudwatch_client.get_dashboard(DashboardName=dashboard_name)
+        body = json.loads(dashboard['DashboardBody'])
+        assert body['widgets'][0]['type'] == "alarm"
+        assert body['widgets'][0]['properties']['alarms'] == [alarm_arn]
+
+        cr = k8s.get_resource(ref)
+        assert cr['spec']['widgets'][0]['alarm']['alarms'] == ["${%s}" % metric_alarm_ref.name]
+
+    def test_dashboard_body_from(self, body_from_dashboard, cloudwatch_client):
+        (ref, cr, core_v1, config_map_name) = body_from_dashboard
+        k8s.wait_on_condition(ref, condition.CONDITION_TYPE_RESOURCE_SYNCED, "True")
+        condition.assert_synced(ref)
+
+        # The body is read from the ConfigMap and the variables are substituted
+        dashboard_name = cr['spec']['dashboardName']
+        dashboard = cloudwatch_client.get_dashboard(DashboardName=dashboard_name)
+        properties = json.loads(dashboard['DashboardBody'])['widgets'][0]['properties']
+        assert properties['metrics'] == [
+            ["ContainerInsights", "node_cpu_utilization", "ClusterName", "test-cluster"],
+        ]
+        assert properties['region'] == "us-west-2"
+        assert properties['title'] == "CPU"
+
+        cr = k8s.get_resource(ref)
+        assert 'dashboardBody' not in cr['spec']
+
+        # Changing the ConfigMap updates the dashboard
+        core_v1.patch_namespaced_config_map(config_map_name, "default", {
+            "data": {"dashboard.json": DASHBOARD_TEMPLATE % "Node CPU"},
+        })
+        time.sleep(CHECK_WAIT_SECONDS)
+        k8s.wait_on_condition(ref, condition.CONDITION_TYPE_RESOURCE_SYNCED, "True")
+
+        dashboard = cloudwatch_client.get_dashboard(DashboardName=dashboard_name)
+        properties = json.loads(dashboard['DashboardBody'])['widgets'][0]['properties']
+        assert properties['title'] == "Node CPU"
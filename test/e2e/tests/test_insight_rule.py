@@ -0,0 +1,111 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the CloudWatch API InsightRule resource
+"""
+
+import json
+import time
+
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_cloudwatch_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e import condition
+from e2e import insight_rule
+
+RESOURCE_PLURAL = 'insightrules'
+
+CHECK_STATUS_WAIT_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 5
+
+
+@pytest.fixture
+def _insight_rule():
+    rule_name = random_suffix_name("ack-test-insight-rule", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["INSIGHT_RULE_NAME"] = rule_name
+    resource_data = load_cloudwatch_resource(
+        "insight_rule",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        rule_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    insight_rule.wait_until_deleted(rule_name)
+
+
+@service_marker
+@pytest.mark.canary
+class TestInsightRule:
+    def test_crud(self, _insight_rule):
+        (ref, cr) = _insight_rule
+        rule_name = ref.name
+
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        condition.assert_synced(ref)
+
+        rule = insight_rule.get(rule_name)
+        assert rule is not None
+        assert rule['State'] == "ENABLED"
+
+        cr = k8s.get_resource(ref)
+        arn = cr["status"]["ackResourceMetadata"]["arn"]
+        assert arn.endswith(f":insight-rule/{rule_name}")
+        assert cr["status"]["schema"] is not None
+
+        tags = insight_rule.get_tags(arn)
+        assert {"Key": "environment", "Value": "dev"} in tags
+
+        # Disable the rule, change its definition and its tags
+        definition = json.loads(cr["spec"]["ruleDefinition"])
+        definition["Contribution"]["Keys"] = ["$.srcAddr"]
+        updates = {
+            "spec": {
+                "ruleDefinition": json.dumps(definition),
+                "ruleState": "DISABLED",
+                "tags": [{"key": "environment", "value": "prod"}],
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        rule = insight_rule.get(rule_name)
+        assert rule['State'] == "DISABLED"
+        assert json.loads(rule['Definition'])["Contribution"]["Keys"] == ["$.srcAddr"]
+
+        tags = insight_rule.get_tags(arn)
+        assert {"Key": "environment", "Value": "prod"} in tags
+        assert {"Key": "environment", "Value": "dev"} not in tags
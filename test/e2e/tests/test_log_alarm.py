@@ -0,0 +1,140 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the CloudWatch API LogAlarm resource
+"""
+
+import time
+
+import boto3
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_cloudwatch_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e import condition
+from e2e import log_alarm
+from e2e.bootstrap_resources import get_bootstrap_resources
+
+RESOURCE_PLURAL = 'logalarms'
+
+CHECK_STATUS_WAIT_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 30
+DELETE_WAIT_AFTER_SECONDS = 5
+
+
+@pytest.fixture
+def _log_group():
+    log_group_name = random_suffix_name("/ack-test/log-alarm", 32)
+    c = boto3.client('logs')
+    c.create_log_group(logGroupName=log_group_name)
+
+    yield log_group_name
+
+    c.delete_log_group(logGroupName=log_group_name)
+
+
+@pytest.fixture
+def _log_alarm(_log_group):
+    log_alarm_name = random_suffix_name("ack-test-log-alarm", 24)
+
+    resources = get_bootstrap_resources()
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["LOG_ALARM_NAME"] = log_alarm_name
+    replacements["LOG_GROUP_NAME"] = _log_group
+    replacements["ROLE_ARN"] = resources.ScheduledQueryRole.arn
+
+    resource_data = load_cloudwatch_resource(
+        "log_alarm",
+        additional_replacements=replacements,
+    )
+
+    # Create the k8s resource
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        log_alarm_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr)
+
+    # Try to delete, if doesn't already exist
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    log_alarm.wait_until_deleted(log_alarm_name)
+
+
+@service_marker
+@pytest.mark.canary
+class TestLogAlarm:
+    def test_crud(self, _log_alarm):
+        (ref, cr) = _log_alarm
+        log_alarm_name = ref.name
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+        condition.assert_synced(ref)
+
+        alarm = log_alarm.get(log_alarm_name)
+        assert alarm is not None
+        assert alarm['Threshold'] == 10
+        config = alarm['ScheduledQueryConfiguration']
+        assert config['ScheduleConfiguration']['ScheduleExpression'] == 'rate(5 minutes)'
+
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["queryARN"] == config['QueryARN']
+        assert "stateValue" in cr["status"]
+
+        updates = {
+            "spec": {
+                "threshold": 20,
+                "queryResultsToAlarm": 2,
+                "queryResultsToEvaluate": 3,
+            }
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        alarm = log_alarm.get(log_alarm_name)
+        assert alarm['Threshold'] == 20
+        assert alarm['QueryResultsToAlarm'] == 2
+        assert alarm['QueryResultsToEvaluate'] == 3
+
+        # Tag-only changes are applied with TagResource/UntagResource
+        updates = {
+            "spec": {
+                "tags": [
+                    {"key": "environment", "value": "test"}
+                ]
+            }
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        tags = log_alarm.get_tags(alarm['AlarmArn'])
+        assert {"Key": "environment", "Value": "test"} in tags, \
+            f"Expected environment tag after update, got {tags}"
+        assert not any(t["Key"] == "Environment" for t in tags), \
+            f"Expected Environment tag to be removed, got {tags}"
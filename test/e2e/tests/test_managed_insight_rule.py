@@ -0,0 +1,127 @@
+# Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"). You may
+# not use this file except in compliance with the License. A copy of the
+# License is located at
+#
+#	 http://aws.amazon.com/apache2.0/
+#
+# or in the "license" file accompanying this file. This file is distributed
+# on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+# express or implied. See the License for the specific language governing
+# permissions and limitations under the License.
+
+"""Integration tests for the CloudWatch API ManagedInsightRule resource
+"""
+
+import time
+
+import boto3
+import pytest
+
+from acktest.k8s import resource as k8s
+from acktest.resources import random_suffix_name
+from e2e import service_marker, CRD_GROUP, CRD_VERSION, load_cloudwatch_resource
+from e2e.replacement_values import REPLACEMENT_VALUES
+from e2e import condition
+from e2e import managed_insight_rule
+
+RESOURCE_PLURAL = 'managedinsightrules'
+
+CHECK_STATUS_WAIT_SECONDS = 10
+MODIFY_WAIT_AFTER_SECONDS = 10
+DELETE_WAIT_AFTER_SECONDS = 5
+
+
+@pytest.fixture
+def _table():
+    table_name = random_suffix_name("ack-test-insights-table", 32)
+
+    c = boto3.client('dynamodb')
+    resp = c.create_table(
+        TableName=table_name,
+        AttributeDefinitions=[{"AttributeName": "id", "AttributeType": "S"}],
+        KeySchema=[{"AttributeName": "id", "KeyType": "HASH"}],
+        BillingMode="PAY_PER_REQUEST",
+    )
+    c.get_waiter('table_exists').wait(TableName=table_name)
+
+    yield resp['TableDescription']['TableArn']
+
+    c.delete_table(TableName=table_name)
+
+
+@pytest.fixture
+def _managed_insight_rule(_table):
+    resource_arn = _table
+    templates = managed_insight_rule.list_templates(resource_arn)
+    assert len(templates) > 0
+    template_name = templates[0]['TemplateName']
+
+    rule_name = random_suffix_name("ack-test-managed-rule", 32)
+
+    replacements = REPLACEMENT_VALUES.copy()
+    replacements["MANAGED_INSIGHT_RULE_NAME"] = rule_name
+    replacements["RESOURCE_ARN"] = resource_arn
+    replacements["TEMPLATE_NAME"] = template_name
+    resource_data = load_cloudwatch_resource(
+        "managed_insight_rule",
+        additional_replacements=replacements,
+    )
+
+    ref = k8s.CustomResourceReference(
+        CRD_GROUP, CRD_VERSION, RESOURCE_PLURAL,
+        rule_name, namespace="default",
+    )
+    k8s.create_custom_resource(ref, resource_data)
+    cr = k8s.wait_resource_consumed_by_controller(ref)
+
+    assert cr is not None
+    assert k8s.get_resource_exists(ref)
+
+    yield (ref, cr, resource_arn, template_name)
+
+    _, deleted = k8s.delete_custom_resource(
+        ref,
+        period_length=DELETE_WAIT_AFTER_SECONDS,
+    )
+    assert deleted
+
+    managed_insight_rule.wait_until_deleted(resource_arn, template_name)
+
+
+@service_marker
+@pytest.mark.canary
+class TestManagedInsightRule:
+    def test_crud(self, _managed_insight_rule):
+        (ref, cr, resource_arn, template_name) = _managed_insight_rule
+
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        condition.assert_synced(ref)
+
+        rule = managed_insight_rule.get(resource_arn, template_name)
+        assert rule is not None
+
+        cr = k8s.get_resource(ref)
+        rule_name = rule['RuleState']['RuleName']
+        assert cr["status"]["ruleName"] == rule_name
+        assert cr["status"]["ruleState"] == rule['RuleState']['State']
+        arn = cr["status"]["ackResourceMetadata"]["arn"]
+        assert arn.endswith(f":insight-rule/{rule_name}")
+
+        tags = managed_insight_rule.get_tags(arn)
+        assert {"Key": "environment", "Value": "dev"} in tags
+
+        updates = {
+            "spec": {
+                "tags": [{"key": "environment", "value": "prod"}],
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        tags = managed_insight_rule.get_tags(arn)
+        assert {"Key": "environment", "Value": "prod"} in tags
+        assert {"Key": "environment", "Value": "dev"} not in tags
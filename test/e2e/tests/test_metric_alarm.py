@@ -79,6 +79,12 @@ def _evaluation_window_metric_alarm():
         "ack-test-eval-window", "metric_alarm_evaluation_window")
 
 
+@pytest.fixture
+def _typed_actions_metric_alarm():
+    yield from _make_metric_alarm(
+        "ack-test-typed-actions", "metric_alarm_typed_actions")
+
+
 @pytest.fixture
 def _promql_metric_alarm():
     yield from _make_metric_alarm(
@@ -99,6 +105,47 @@ class TestMetricAlarm:
         assert metric_alarm.exists(metric_alarm_name)
         assert k8s.get_resource_exists(ref)
 
+        # The alarm state read from DescribeAlarms is surfaced in the status
+        # and mirrored by the AlarmFiring condition
+        alarm = metric_alarm.get(metric_alarm_name)
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["stateValue"] == alarm["StateValue"]
+        assert cr["status"]["stateReason"] == alarm["StateReason"]
+        assert cr["status"]["ackResourceMetadata"]["arn"] == alarm["AlarmArn"]
+
+        condition.assert_type_status(
+            ref,
+            condition.CONDITION_TYPE_ALARM_FIRING,
+            alarm["StateValue"] == "ALARM")
+
+        # Tags are not updated by PutMetricAlarm, so changes to spec.tags
+        # must be applied with TagResource/UntagResource
+        updates = {
+            "spec": {
+                "tags": [
+                    {"key": "environment", "value": "test"},
+                ]
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        tags = metric_alarm.get_tags(alarm["AlarmArn"])
+        assert {"Key": "environment", "Value": "test"} in tags
+
+        updates = {
+            "spec": {
+                "tags": []
+            }
+        }
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        tags = metric_alarm.get_tags(alarm["AlarmArn"])
+        assert not any(t["Key"] == "environment" for t in tags)
+
 
 @service_marker
 class TestMetricAlarmEvaluationWindow:
@@ -166,6 +213,63 @@ class TestMetricAlarmEvaluationWindow:
             f"Expected UTC+05:30 after update, got {updated_window['WallClockWindow']}"
 
 
+@service_marker
+class TestMetricAlarmTypedActions:
+    """Covers typedAlarmActions, which the controller renders into action
+    ARNs before calling PutMetricAlarm.
+    """
+
+    def test_crud(self, _typed_actions_metric_alarm):
+        (ref, cr) = _typed_actions_metric_alarm
+        metric_alarm_name = ref.name
+
+        time.sleep(CHECK_STATUS_WAIT_SECONDS)
+
+        condition.assert_synced(ref)
+
+        assert metric_alarm.exists(metric_alarm_name)
+
+        initial_alarm = metric_alarm.get(metric_alarm_name)
+        assert initial_alarm is not None, "MetricAlarm not found in AWS API"
+
+        initial_actions = initial_alarm.get('AlarmActions', [])
+        assert len(initial_actions) == 1, \
+            f"Expected one alarm action on create, got {initial_actions}"
+        assert initial_actions[0].endswith(':opsitem:2#CATEGORY=Performance'), \
+            f"Expected OpsItem action on create, got {initial_actions[0]}"
+
+        # The rendered ARN must not leak back into spec.alarmActions.
+        cr = k8s.get_resource(ref)
+        assert not cr["spec"].get("alarmActions"), \
+            f"Expected no alarmActions on CR, got {cr['spec'].get('alarmActions')}"
+
+        updates = {
+            "spec": {
+                "typedAlarmActions": [
+                    {"opsItem": {"severity": 1}},
+                ],
+            }
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        cr = k8s.wait_resource_consumed_by_controller(ref)
+
+        assert cr is not None
+        assert k8s.get_resource_exists(ref)
+
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        updated_alarm = metric_alarm.get(metric_alarm_name)
+        assert updated_alarm is not None, "MetricAlarm not found in AWS API after update"
+
+        updated_actions = updated_alarm.get('AlarmActions', [])
+        assert len(updated_actions) == 1, \
+            f"Expected one alarm action after update, got {updated_actions}"
+        assert updated_actions[0].endswith(':opsitem:1'), \
+            f"Expected updated OpsItem action, got {updated_actions[0]}"
+
+
 @service_marker
 class TestMetricAlarmPromQL:
     """Covers alarms configured with evaluationCriteria/evaluationInterval.
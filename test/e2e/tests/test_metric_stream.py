@@ -111,4 +111,67 @@ class TestMetricStream:
         
         updated_filters = updated_stream_data.get('IncludeFilters', [])
         assert len(updated_filters) == 1, f"Expected 1 filter after update, got {len(updated_filters)}: {updated_filters}"
-        assert updated_filters[0]['Namespace'] == 'AWS/EC2', f"Expected AWS/EC2 filter, got {updated_filters[0]}"
\ No newline at end of file
+        assert updated_filters[0]['Namespace'] == 'AWS/EC2', f"Expected AWS/EC2 filter, got {updated_filters[0]}"
+        # Tag-only changes are applied with TagResource/UntagResource
+        updates = {
+            "spec": {
+                "tags": [
+                    {"key": "environment", "value": "test"}
+                ]
+            }
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        tags = metric_stream.get_tags(updated_stream_data['Arn'])
+        assert {"Key": "environment", "Value": "test"} in tags, \
+            f"Expected environment tag after update, got {tags}"
+
+        updates = {
+            "spec": {
+                "tags": []
+            }
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        tags = metric_stream.get_tags(updated_stream_data['Arn'])
+        assert not any(t["Key"] == "environment" for t in tags), \
+            f"Expected environment tag to be removed, got {tags}"
+
+        # The stream is stopped and started again through spec.state
+        updates = {
+            "spec": {
+                "state": "stopped"
+            }
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        stream_data = metric_stream.get(metric_stream_name)
+        assert stream_data['State'] == 'stopped', \
+            f"Expected stopped stream, got {stream_data['State']}"
+        cr = k8s.get_resource(ref)
+        assert cr["status"]["streamState"] == 'stopped'
+        assert "creationDate" in cr["status"]
+        assert "lastUpdateDate" in cr["status"]
+
+        updates = {
+            "spec": {
+                "state": "running"
+            }
+        }
+
+        k8s.patch_custom_resource(ref, updates)
+        time.sleep(MODIFY_WAIT_AFTER_SECONDS)
+        condition.assert_synced(ref)
+
+        stream_data = metric_stream.get(metric_stream_name)
+        assert stream_data['State'] == 'running', \
+            f"Expected running stream, got {stream_data['State']}"